@@ -0,0 +1,186 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"strings"
+	"unicode"
+)
+
+// SplitWords разбивает строку на слова для преобразования регистра. Границами слов считаются
+// любые символы, кроме букв и цифр, переход от строчной буквы или цифры к заглавной,
+// а также конец аббревиатуры перед словом с заглавной буквы. Цифры присоединяются
+// к предшествующему слову; апострофы удаляются.
+// Пример: "HTTPServer2Go" → ["HTTP", "Server2", "Go"], "привет_Мир" → ["привет", "Мир"].
+func SplitWords(s string) []string {
+	var words []string
+	var word []rune
+	flush := func() {
+		if len(word) > 0 {
+			words = append(words, string(word))
+			word = word[:0]
+		}
+	}
+
+	runes := []rune(s)
+	for i, r := range runes {
+		switch {
+		case isSearchApostrophe(r):
+			continue
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r):
+			flush()
+			continue
+		case unicode.IsUpper(r) && len(word) > 0:
+			prev := word[len(word)-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			// "fooBar", "v2Beta" и конец аббревиатуры "HTTPServer"
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				flush()
+			}
+		}
+		word = append(word, r)
+	}
+	flush()
+	return words
+}
+
+// joinCase приводит слова строки к нужному регистру и соединяет их разделителем.
+// Функция transform получает индекс слова и само слово.
+func joinCase(s, separator string, transform func(i int, word string) string) string {
+	words := SplitWords(s)
+	for i, word := range words {
+		words[i] = transform(i, word)
+	}
+	return strings.Join(words, separator)
+}
+
+// isAcronym сообщает, является ли слово аббревиатурой: не менее двух букв, и все буквы заглавные.
+func isAcronym(word string) bool {
+	letters := 0
+	for _, r := range word {
+		if unicode.IsLower(r) {
+			return false
+		}
+		if unicode.IsLetter(r) {
+			letters++
+		}
+	}
+	return letters > 1
+}
+
+// ToSnakeCase преобразует строку в snake_case: "HTTPServer2Go" → "http_server2_go".
+func ToSnakeCase(s string) string {
+	return joinCase(s, "_", func(_ int, word string) string { return strings.ToLower(word) })
+}
+
+// ToScreamingSnakeCase преобразует строку в SCREAMING_SNAKE_CASE: "maxRetryCount" → "MAX_RETRY_COUNT".
+func ToScreamingSnakeCase(s string) string {
+	return joinCase(s, "_", func(_ int, word string) string { return strings.ToUpper(word) })
+}
+
+// ToKebabCase преобразует строку в kebab-case: "Привет Мир" → "привет-мир".
+func ToKebabCase(s string) string {
+	return joinCase(s, "-", func(_ int, word string) string { return strings.ToLower(word) })
+}
+
+// ToCamelCase преобразует строку в camelCase: "user_id" → "userId", "HTTP server" → "httpServer".
+func ToCamelCase(s string) string {
+	return joinCase(s, "", func(i int, word string) string {
+		if i == 0 {
+			return strings.ToLower(word)
+		}
+		return Capitalize(word)
+	})
+}
+
+// ToPascalCase преобразует строку в PascalCase: "user_id" → "UserId", "привет мир" → "ПриветМир".
+func ToPascalCase(s string) string {
+	return joinCase(s, "", func(_ int, word string) string { return Capitalize(word) })
+}
+
+// keepAcronym сообщает, нужно ли сохранить регистр слова: аббревиатуры сохраняются,
+// если исходная строка не записана целиком заглавными буквами ("MAX_RETRY").
+func keepAcronym(s, word string) bool {
+	return isAcronym(word) && strings.IndexFunc(s, unicode.IsLower) >= 0
+}
+
+// recaseWords приводит слова строки к нужному регистру на месте: знаки препинания, пробелы
+// и апострофы внутри слов сохраняются, а подчеркивания и границы слов внутри идентификаторов
+// (см. SplitWords) заменяются пробелом. Функция transform получает индекс слова и само слово.
+func recaseWords(s string, transform func(i int, word string) string) string {
+	var b strings.Builder
+	var word []rune
+	n := 0
+	space := false // перед следующим словом нужен пробел
+	flush := func() {
+		if len(word) == 0 {
+			return
+		}
+		if space && b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		space = false
+		b.WriteString(transform(n, string(word)))
+		n++
+		word = word[:0]
+	}
+
+	runes := []rune(s)
+	for i, r := range runes {
+		switch {
+		case isSearchApostrophe(r) && len(word) > 0:
+			// "don't" остается одним словом
+			word = append(word, r)
+			continue
+		case r == '_':
+			flush()
+			space = true
+			continue
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r):
+			flush()
+			space = false
+			b.WriteRune(r)
+			continue
+		case unicode.IsUpper(r) && len(word) > 0:
+			prev := word[len(word)-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				flush()
+				space = true
+			}
+		}
+		word = append(word, r)
+	}
+	flush()
+	return b.String()
+}
+
+// ToTitleCase преобразует строку в Title Case: каждое слово с заглавной буквы,
+// аббревиатуры, знаки препинания и апострофы сохраняются. "httpServer error" → "Http Server Error",
+// "HTTPServer" → "HTTP Server", "don't stop, please" → "Don't Stop, Please".
+func ToTitleCase(s string) string {
+	return recaseWords(s, func(_ int, word string) string {
+		if keepAcronym(s, word) {
+			return word
+		}
+		return Capitalize(word)
+	})
+}
+
+// ToSentenceCase преобразует строку в Sentence case: первое слово с заглавной буквы,
+// остальные строчными, аббревиатуры, знаки препинания и апострофы сохраняются.
+// "userIDField" → "User ID field", "ПРИВЕТ, МИР!" → "Привет, мир!".
+func ToSentenceCase(s string) string {
+	return recaseWords(s, func(i int, word string) string {
+		switch {
+		case keepAcronym(s, word):
+			return word
+		case i == 0:
+			return Capitalize(word)
+		}
+		return strings.ToLower(word)
+	})
+}
@@ -0,0 +1,117 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitWords(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []string
+	}{
+		{"HTTPServer2Go", []string{"HTTP", "Server2", "Go"}},
+		{"helloWorld", []string{"hello", "World"}},
+		{"XMLHttpRequest", []string{"XML", "Http", "Request"}},
+		{"userID", []string{"user", "ID"}},
+		{"base64Encode", []string{"base64", "Encode"}},
+		{"snake_case-and kebab.case", []string{"snake", "case", "and", "kebab", "case"}},
+		{"SCREAMING_SNAKE", []string{"SCREAMING", "SNAKE"}},
+		{"приветМир", []string{"привет", "Мир"}},
+		{"ИННОрганизации", []string{"ИНН", "Организации"}},
+		{"Don't stop", []string{"Dont", "stop"}},
+		{"  __  ", nil},
+		{"", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := SplitWords(tt.input); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("SplitWords(%q) = %q; ожидается %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCaseConversions(t *testing.T) {
+	tests := []struct {
+		input     string
+		snake     string
+		screaming string
+		kebab     string
+		camel     string
+		pascal    string
+		title     string
+		sentence  string
+	}{
+		{
+			"HTTPServer2Go",
+			"http_server2_go", "HTTP_SERVER2_GO", "http-server2-go",
+			"httpServer2Go", "HttpServer2Go", "HTTP Server2 Go", "HTTP server2 go",
+		},
+		{
+			"user_id",
+			"user_id", "USER_ID", "user-id",
+			"userId", "UserId", "User Id", "User id",
+		},
+		{
+			"userIDField",
+			"user_id_field", "USER_ID_FIELD", "user-id-field",
+			"userIdField", "UserIdField", "User ID Field", "User ID field",
+		},
+		{
+			"MAX_RETRY_COUNT",
+			"max_retry_count", "MAX_RETRY_COUNT", "max-retry-count",
+			"maxRetryCount", "MaxRetryCount", "Max Retry Count", "Max retry count",
+		},
+		{
+			"привет, мир!",
+			"привет_мир", "ПРИВЕТ_МИР", "привет-мир",
+			"приветМир", "ПриветМир", "Привет, Мир!", "Привет, мир!",
+		},
+		{
+			"don't stop, please",
+			"dont_stop_please", "DONT_STOP_PLEASE", "dont-stop-please",
+			"dontStopPlease", "DontStopPlease", "Don't Stop, Please", "Don't stop, please",
+		},
+		{
+			"ПРИВЕТ, МИР!",
+			"привет_мир", "ПРИВЕТ_МИР", "привет-мир",
+			"приветМир", "ПриветМир", "Привет, Мир!", "Привет, мир!",
+		},
+		{
+			"ДатаРожденияИНН",
+			"дата_рождения_инн", "ДАТА_РОЖДЕНИЯ_ИНН", "дата-рождения-инн",
+			"датаРожденияИнн", "ДатаРожденияИнн", "Дата Рождения ИНН", "Дата рождения ИНН",
+		},
+		{"", "", "", "", "", "", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			checks := []struct {
+				name     string
+				fn       func(string) string
+				expected string
+			}{
+				{"ToSnakeCase", ToSnakeCase, tt.snake},
+				{"ToScreamingSnakeCase", ToScreamingSnakeCase, tt.screaming},
+				{"ToKebabCase", ToKebabCase, tt.kebab},
+				{"ToCamelCase", ToCamelCase, tt.camel},
+				{"ToPascalCase", ToPascalCase, tt.pascal},
+				{"ToTitleCase", ToTitleCase, tt.title},
+				{"ToSentenceCase", ToSentenceCase, tt.sentence},
+			}
+			for _, c := range checks {
+				if got := c.fn(tt.input); got != c.expected {
+					t.Errorf("%s(%q) = %q; ожидается %q", c.name, tt.input, got, c.expected)
+				}
+			}
+		})
+	}
+}
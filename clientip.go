@@ -0,0 +1,291 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"errors"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+const headerForwarded = "Forwarded"
+
+var (
+	// ErrInvalidRemoteAddr возвращается, если http.Request.RemoteAddr не содержит IP-адреса.
+	ErrInvalidRemoteAddr = errors.New("helpers: некорректный RemoteAddr")
+	// ErrInvalidForwarded возвращается при синтаксической ошибке в заголовке Forwarded.
+	ErrInvalidForwarded = errors.New("helpers: некорректный заголовок Forwarded")
+)
+
+// ForwardedElement — один элемент заголовка Forwarded (RFC 7239).
+// Значения хранятся без кавычек; имена параметров регистронезависимы.
+type ForwardedElement struct {
+	For   string
+	By    string
+	Host  string
+	Proto string
+}
+
+// ClientIP — IP-адрес клиента и его классификация.
+type ClientIP struct {
+	Addr netip.Addr
+	// Private — адрес частный или зарезервированный (см. IsPrivateOrReservedIP)
+	Private bool
+	// FromHeader — адрес получен из заголовка, а не из RemoteAddr
+	FromHeader bool
+}
+
+// ClientIPResolver определяет реальный IP-адрес клиента за цепочкой доверенных прокси.
+//
+// Заголовок Header учитывается, только если непосредственный собеседник (RemoteAddr)
+// входит в TrustedProxies. Цепочка адресов обходится справа налево: доверенные
+// прокси пропускаются, первый недоверенный адрес считается адресом клиента.
+// Все, что левее него, мог подставить сам клиент, поэтому игнорируется.
+//
+// Header должен быть тем заголовком, который выставляет ваш прокси. Любой другой
+// заголовок проходит через прокси без изменений и полностью контролируется клиентом,
+// поэтому заголовок по умолчанию не выбирается.
+type ClientIPResolver struct {
+	// TrustedProxies — адреса и сети доверенных прокси; nil означает, что прокси нет
+	TrustedProxies *IPSet
+	// Header — заголовок, который выставляет прокси: Forwarded, X-Forwarded-For, X-Real-IP и т. п.;
+	// пустое значение означает, что используется только RemoteAddr
+	Header string
+}
+
+// NewClientIPResolver создает ClientIPResolver для заголовка header из списка адресов,
+// CIDR и диапазонов доверенных прокси.
+func NewClientIPResolver(header string, trustedProxies ...string) (*ClientIPResolver, error) {
+	set, err := NewIPSet(trustedProxies...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientIPResolver{TrustedProxies: set, Header: header}, nil
+}
+
+// Resolve возвращает IP-адрес клиента для запроса.
+func (c *ClientIPResolver) Resolve(r *http.Request) (ClientIP, error) {
+	remote, ok := parseHostAddr(r.RemoteAddr)
+	if !ok {
+		return ClientIP{}, ErrInvalidRemoteAddr
+	}
+	if c.Header == "" || !c.TrustedProxies.Contains(remote) {
+		return newClientIP(remote, false), nil
+	}
+
+	// Обходим цепочку справа налево, начиная от ближайшего к нам прокси
+	chain := forwardingChain(r.Header, c.Header)
+	client, found := remote, false
+	for i := len(chain) - 1; i >= 0; i-- {
+		addr, ok := parseHostAddr(chain[i])
+		if !ok {
+			// Неизвестный, обфусцированный или некорректный узел: дальше доверять цепочке нельзя
+			break
+		}
+		client, found = addr, true
+		if !c.TrustedProxies.Contains(addr) {
+			break
+		}
+	}
+	return newClientIP(client, found), nil
+}
+
+// ClientIPFromRequest возвращает IP-адрес клиента, доверяя заголовку header только от trustedProxies.
+func ClientIPFromRequest(r *http.Request, trustedProxies *IPSet, header string) (ClientIP, error) {
+	resolver := ClientIPResolver{TrustedProxies: trustedProxies, Header: header}
+	return resolver.Resolve(r)
+}
+
+// newClientIP формирует результат с классификацией адреса.
+func newClientIP(addr netip.Addr, fromHeader bool) ClientIP {
+	addr = addr.Unmap().WithZone("")
+	return ClientIP{
+		Addr:       addr,
+		Private:    isPrivateOrReservedAddr(addr),
+		FromHeader: fromHeader,
+	}
+}
+
+// forwardingChain собирает адреса из всех экземпляров заголовка в порядке следования.
+// Элементы Forwarded разбираются по отдельности: некорректный элемент, добавленный
+// клиентом, превращается в пустой узел и не мешает разобрать элементы прокси правее него.
+func forwardingChain(h http.Header, header string) []string {
+	values := h.Values(header)
+	if len(values) == 0 {
+		return nil
+	}
+
+	var chain []string
+	if http.CanonicalHeaderKey(header) == headerForwarded {
+		for _, value := range values {
+			for _, part := range splitForwardedElements(value) {
+				elements, err := ParseForwarded(part)
+				if err != nil {
+					chain = append(chain, "")
+					continue
+				}
+				for _, e := range elements {
+					chain = append(chain, e.For)
+				}
+			}
+		}
+		return chain
+	}
+
+	for _, value := range values {
+		for part := range strings.SplitSeq(value, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				chain = append(chain, part)
+			}
+		}
+	}
+	return chain
+}
+
+// splitForwardedElements делит значение Forwarded на элементы по запятым вне кавычек.
+// Незакрытая кавычка не должна поглощать элементы, дописанные прокси, поэтому
+// остаток после нее делится по всем запятым.
+func splitForwardedElements(value string) []string {
+	var parts []string
+	start, quoted := 0, false
+	for i := 0; i < len(value); i++ {
+		switch c := value[i]; {
+		case quoted && c == '\\':
+			i++
+		case c == '"':
+			quoted = !quoted
+		case !quoted && c == ',':
+			parts = append(parts, value[start:i])
+			start = i + 1
+		}
+	}
+	if quoted {
+		return append(parts, strings.Split(value[start:], ",")...)
+	}
+	return append(parts, value[start:])
+}
+
+// parseHostAddr разбирает адрес в одном из видов: "ip", "ip:port", "[ipv6]", "[ipv6]:port".
+func parseHostAddr(s string) (netip.Addr, bool) {
+	s = strings.TrimSpace(s)
+	if addr, err := netip.ParseAddr(s); err == nil {
+		return addr.Unmap().WithZone(""), true
+	}
+	if addrPort, err := netip.ParseAddrPort(s); err == nil {
+		return addrPort.Addr().Unmap().WithZone(""), true
+	}
+	if strings.HasPrefix(s, "[") && strings.HasSuffix(s, "]") {
+		if addr, err := netip.ParseAddr(s[1 : len(s)-1]); err == nil {
+			return addr.Unmap().WithZone(""), true
+		}
+	}
+	return netip.Addr{}, false
+}
+
+// ParseForwarded разбирает значение заголовка Forwarded согласно RFC 7239.
+func ParseForwarded(value string) ([]ForwardedElement, error) {
+	var elements []ForwardedElement
+	var current ForwardedElement
+	empty := true
+
+	i := 0
+	for {
+		// Пропускаем пробелы перед параметром
+		for i < len(value) && (value[i] == ' ' || value[i] == '\t') {
+			i++
+		}
+		if i == len(value) {
+			break
+		}
+
+		// Имя параметра (token)
+		start := i
+		for i < len(value) && isForwardedTokenChar(value[i]) {
+			i++
+		}
+		name := strings.ToLower(value[start:i])
+		if name == "" || i == len(value) || value[i] != '=' {
+			return nil, ErrInvalidForwarded
+		}
+		i++
+
+		// Значение: token или quoted-string
+		var val string
+		if i < len(value) && value[i] == '"' {
+			var b strings.Builder
+			i++
+			closed := false
+			for i < len(value) {
+				ch := value[i]
+				if ch == '\\' && i+1 < len(value) {
+					b.WriteByte(value[i+1])
+					i += 2
+					continue
+				}
+				i++
+				if ch == '"' {
+					closed = true
+					break
+				}
+				b.WriteByte(ch)
+			}
+			if !closed {
+				return nil, ErrInvalidForwarded
+			}
+			val = b.String()
+		} else {
+			start = i
+			for i < len(value) && isForwardedTokenChar(value[i]) {
+				i++
+			}
+			val = value[start:i]
+		}
+
+		switch name {
+		case "for":
+			current.For = val
+		case "by":
+			current.By = val
+		case "host":
+			current.Host = val
+		case "proto":
+			current.Proto = val
+		}
+		empty = false
+
+		// Разделитель: ';' внутри элемента, ',' между элементами
+		for i < len(value) && (value[i] == ' ' || value[i] == '\t') {
+			i++
+		}
+		if i == len(value) {
+			break
+		}
+		switch value[i] {
+		case ';':
+		case ',':
+			elements = append(elements, current)
+			current, empty = ForwardedElement{}, true
+		default:
+			return nil, ErrInvalidForwarded
+		}
+		i++
+	}
+
+	if !empty {
+		elements = append(elements, current)
+	}
+	return elements, nil
+}
+
+// isForwardedTokenChar проверяет, допустим ли символ в token (RFC 7230).
+func isForwardedTokenChar(c byte) bool {
+	switch {
+	case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		return true
+	}
+	return strings.IndexByte("!#$%&'*+-.^_`|~:", c) >= 0
+}
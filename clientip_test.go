@@ -0,0 +1,144 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"errors"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestParseForwarded(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    []ForwardedElement
+		wantErr bool
+	}{
+		{"1", "for=192.0.2.60;proto=http;by=203.0.113.43", []ForwardedElement{{For: "192.0.2.60", Proto: "http", By: "203.0.113.43"}}, false},
+		{"2", `for="[2001:db8:cafe::17]:4711"`, []ForwardedElement{{For: "[2001:db8:cafe::17]:4711"}}, false},
+		{"3", "for=192.0.2.43, for=198.51.100.17", []ForwardedElement{{For: "192.0.2.43"}, {For: "198.51.100.17"}}, false},
+		{"4", `For="_gazonk"; HOST=example.com`, []ForwardedElement{{For: "_gazonk", Host: "example.com"}}, false},
+		{"5", `for="a\"b"`, []ForwardedElement{{For: `a"b`}}, false},
+		{"6", "for=unknown, ", []ForwardedElement{{For: "unknown"}}, false},
+		{"7", "", nil, false},
+		{"8", "for", nil, true},
+		{"9", `for="192.0.2.1`, nil, true},
+		{"10", "for=1.1.1.1 for=2.2.2.2", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseForwarded(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseForwarded() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseForwarded() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClientIPResolver(t *testing.T) {
+	const xff, fwd = "X-Forwarded-For", "Forwarded"
+	tests := []struct {
+		name        string
+		header      string
+		remoteAddr  string
+		headers     map[string][]string
+		want        string
+		wantPrivate bool
+		wantHeader  bool
+	}{
+		{"Без прокси", xff, "203.0.113.7:5555", nil, "203.0.113.7", true, false},
+		{"Недоверенный источник игнорирует заголовки", xff, "8.8.8.8:5555",
+			map[string][]string{"X-Forwarded-For": {"1.2.3.4"}}, "8.8.8.8", false, false},
+		{"X-Forwarded-For через доверенный прокси", xff, "10.0.0.1:5555",
+			map[string][]string{"X-Forwarded-For": {"1.2.3.4"}}, "1.2.3.4", false, true},
+		{"Подделанные адреса слева пропускаются", xff, "10.0.0.1:5555",
+			map[string][]string{"X-Forwarded-For": {"6.6.6.6, 1.2.3.4, 10.0.0.2"}}, "1.2.3.4", false, true},
+		{"Несколько экземпляров заголовка", xff, "10.0.0.1:5555",
+			map[string][]string{"X-Forwarded-For": {"6.6.6.6", "1.2.3.4:8080, 10.1.1.1"}}, "1.2.3.4", false, true},
+		{"Все адреса доверенные", xff, "10.0.0.1:5555",
+			map[string][]string{"X-Forwarded-For": {"10.0.0.3, 10.0.0.2"}}, "10.0.0.3", true, true},
+		{"Некорректный узел останавливает обход", xff, "10.0.0.1:5555",
+			map[string][]string{"X-Forwarded-For": {"1.2.3.4, garbage, 10.0.0.2"}}, "10.0.0.2", true, true},
+		{"Заголовок не настроен", "", "10.0.0.1:5555",
+			map[string][]string{"X-Forwarded-For": {"1.2.3.4"}}, "10.0.0.1", true, false},
+		{"Другие заголовки клиента игнорируются", xff, "10.0.0.1:5555",
+			map[string][]string{
+				"Forwarded":       {"for=6.6.6.6"},
+				"X-Real-IP":       {"6.6.6.6"},
+				"X-Forwarded-For": {"1.2.3.4"},
+			}, "1.2.3.4", false, true},
+		{"Forwarded", fwd, "10.0.0.1:5555",
+			map[string][]string{
+				"Forwarded":       {`for="[2001:db8:cafe::17]:4711";proto=https, for=10.0.0.5`},
+				"X-Forwarded-For": {"1.2.3.4"},
+			}, "2001:db8:cafe::17", true, true},
+		{"Обфусцированный узел в Forwarded", fwd, "10.0.0.1:5555",
+			map[string][]string{"Forwarded": {"for=_hidden, for=10.0.0.5"}}, "10.0.0.5", true, true},
+		{"Некорректный элемент клиента в Forwarded", fwd, "10.0.0.1:5555",
+			map[string][]string{"Forwarded": {`for="6.6.6.6, for=x y, for=1.2.3.4`}}, "1.2.3.4", false, true},
+		{"Некорректный Forwarded целиком", fwd, "10.0.0.1:5555",
+			map[string][]string{"Forwarded": {"for"}}, "10.0.0.1", true, false},
+		{"X-Real-IP", "X-Real-IP", "[2001:db8:ffff::1]:443",
+			map[string][]string{"X-Real-IP": {"93.184.216.34"}}, "93.184.216.34", false, true},
+		{"Доверенный прокси без заголовков", xff, "10.0.0.1:5555", nil, "10.0.0.1", true, false},
+		{"IPv4-mapped RemoteAddr", xff, "[::ffff:10.0.0.1]:5555",
+			map[string][]string{"X-Forwarded-For": {"1.2.3.4"}}, "1.2.3.4", false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolver, err := NewClientIPResolver(tt.header, "10.0.0.0/8", "2001:db8:ffff::/48")
+			if err != nil {
+				t.Fatalf("NewClientIPResolver() error = %v", err)
+			}
+			r := httptest.NewRequest("GET", "/", nil)
+			r.RemoteAddr = tt.remoteAddr
+			for k, values := range tt.headers {
+				for _, v := range values {
+					r.Header.Add(k, v)
+				}
+			}
+			got, err := resolver.Resolve(r)
+			if err != nil {
+				t.Fatalf("Resolve() error = %v", err)
+			}
+			if got.Addr.String() != tt.want || got.Private != tt.wantPrivate || got.FromHeader != tt.wantHeader {
+				t.Errorf("Resolve() = %+v, want %s private=%v fromHeader=%v", got, tt.want, tt.wantPrivate, tt.wantHeader)
+			}
+		})
+	}
+}
+
+func TestClientIPResolverErrors(t *testing.T) {
+	if _, err := NewClientIPResolver("X-Forwarded-For", "bad"); err == nil {
+		t.Error("Ожидалась ошибка для некорректного списка прокси")
+	}
+
+	r := httptest.NewRequest("GET", "/", nil)
+	r.RemoteAddr = "not-an-address"
+	if _, err := ClientIPFromRequest(r, nil, "X-Forwarded-For"); !errors.Is(err, ErrInvalidRemoteAddr) {
+		t.Errorf("ClientIPFromRequest() error = %v, want %v", err, ErrInvalidRemoteAddr)
+	}
+
+	set, _ := NewIPSet("127.0.0.1")
+	r.RemoteAddr = "127.0.0.1:80"
+	r.Header.Set("Forwarded", "for")
+	if got, err := ClientIPFromRequest(r, set, "Forwarded"); err != nil || got.Addr.String() != "127.0.0.1" {
+		t.Errorf("ClientIPFromRequest() = %+v, %v, want 127.0.0.1", got, err)
+	}
+
+	// Заголовок, выставляемый прокси
+	resolver := ClientIPResolver{TrustedProxies: set, Header: "X-Real-IP"}
+	r.Header.Set("X-Real-IP", "1.1.1.1")
+	got, err := resolver.Resolve(r)
+	if err != nil || got.Addr.String() != "1.1.1.1" {
+		t.Errorf("Resolve() = %+v, %v, want 1.1.1.1", got, err)
+	}
+}
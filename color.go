@@ -0,0 +1,413 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrInvalidColor возвращается, если строку не удалось разобрать как цвет.
+	ErrInvalidColor = errors.New("helpers: некорректный цвет")
+
+	funcColorPattern = regexp.MustCompile(`^(rgba?|hsla?)\(\s*([^)]*?)\s*\)$`)
+)
+
+// Color — цвет в пространстве sRGB с альфа-каналом.
+type Color struct {
+	R, G, B uint8
+	// A — непрозрачность от 0 (прозрачный) до 1 (непрозрачный)
+	A float64
+}
+
+// HSL — цвет в модели HSL: H в градусах [0, 360), S и L в диапазоне [0, 1].
+type HSL struct {
+	H, S, L float64
+}
+
+// HSV — цвет в модели HSV: H в градусах [0, 360), S и V в диапазоне [0, 1].
+type HSV struct {
+	H, S, V float64
+}
+
+// RGB создает непрозрачный цвет из компонент.
+func RGB(r, g, b uint8) Color {
+	return Color{R: r, G: g, B: b, A: 1}
+}
+
+// IsColor проверяет, является ли строка цветом, который понимает ParseColor.
+func IsColor(s string) bool {
+	_, err := ParseColor(s)
+	return err == nil
+}
+
+// ParseColor разбирает цвет в одном из форматов:
+// #RGB, #RGBA, #RRGGBB, #RRGGBBAA (символ # необязателен), rgb()/rgba(),
+// hsl()/hsla() и именованные цвета CSS (включая transparent).
+func ParseColor(s string) (Color, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+
+	if c, ok := cssNamedColors[s]; ok {
+		return c, nil
+	}
+	if isHexColorWithAlpha(s) {
+		return parseHexColor(strings.TrimPrefix(s, "#")), nil
+	}
+	if m := funcColorPattern.FindStringSubmatch(s); m != nil {
+		return parseFuncColor(m[1], m[2])
+	}
+	return Color{}, ErrInvalidColor
+}
+
+// MustParseColor аналогична ParseColor, но паникует при ошибке.
+func MustParseColor(s string) Color {
+	c, err := ParseColor(s)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// isHexColorWithAlpha проверяет записи #RGB и #RRGGBB (см. IsHexColor), а также #RGBA и #RRGGBBAA.
+func isHexColorWithAlpha(s string) bool {
+	hex := strings.TrimPrefix(s, "#")
+	switch len(hex) {
+	case 4, 8:
+		// Первые и последние 3 (6) цифр вместе покрывают всю запись
+		n := len(hex) / 4 * 3
+		return IsHexColor(hex[:n]) && IsHexColor(hex[len(hex)-n:])
+	}
+	return IsHexColor(s)
+}
+
+// parseHexColor разбирает проверенную HEX-строку из 3, 4, 6 или 8 цифр.
+func parseHexColor(hex string) Color {
+	// Короткая запись: каждая цифра удваивается
+	if len(hex) <= 4 {
+		var b strings.Builder
+		for _, ch := range hex {
+			b.WriteRune(ch)
+			b.WriteRune(ch)
+		}
+		hex = b.String()
+	}
+	v, _ := strconv.ParseUint(hex, 16, 32)
+	if len(hex) == 6 {
+		return Color{R: uint8(v >> 16), G: uint8(v >> 8), B: uint8(v), A: 1}
+	}
+	return Color{R: uint8(v >> 24), G: uint8(v >> 16), B: uint8(v >> 8), A: float64(uint8(v)) / 255}
+}
+
+// parseFuncColor разбирает функциональную запись rgb()/rgba()/hsl()/hsla().
+// Поддерживаются разделители-запятые и синтаксис CSS Color 4 ("rgb(0 0 0 / 50%)").
+func parseFuncColor(name, args string) (Color, error) {
+	var parts []string
+	alpha := "1"
+	if strings.Contains(args, ",") {
+		parts = strings.Split(args, ",")
+		if len(parts) == 4 {
+			alpha = parts[3]
+			parts = parts[:3]
+		}
+	} else {
+		main, a, hasAlpha := strings.Cut(args, "/")
+		parts = strings.Fields(main)
+		if hasAlpha {
+			alpha = a
+		}
+	}
+	if len(parts) != 3 {
+		return Color{}, ErrInvalidColor
+	}
+
+	a, err := parseColorComponent(alpha, 1)
+	if err != nil || a < 0 || a > 1 {
+		return Color{}, ErrInvalidColor
+	}
+
+	if strings.HasPrefix(name, "rgb") {
+		var rgb [3]uint8
+		for i, p := range parts {
+			v, err := parseColorComponent(p, 255)
+			if err != nil || v < 0 || v > 255 {
+				return Color{}, ErrInvalidColor
+			}
+			rgb[i] = uint8(math.Round(v))
+		}
+		return Color{R: rgb[0], G: rgb[1], B: rgb[2], A: a}, nil
+	}
+
+	h, err := strconv.ParseFloat(strings.TrimSuffix(strings.TrimSpace(parts[0]), "deg"), 64)
+	if err != nil || math.IsNaN(h) || math.IsInf(h, 0) {
+		return Color{}, ErrInvalidColor
+	}
+	sat, err1 := parseColorComponent(parts[1], 1)
+	light, err2 := parseColorComponent(parts[2], 1)
+	if err1 != nil || err2 != nil || !strings.HasSuffix(strings.TrimSpace(parts[1]), "%") ||
+		!strings.HasSuffix(strings.TrimSpace(parts[2]), "%") || sat < 0 || sat > 1 || light < 0 || light > 1 {
+		return Color{}, ErrInvalidColor
+	}
+	c := HSL{H: h, S: sat, L: light}.Color()
+	c.A = a
+	return c, nil
+}
+
+// parseColorComponent разбирает число или процент; процент масштабируется к scale.
+func parseColorComponent(s string, scale float64) (float64, error) {
+	s = strings.TrimSpace(s)
+	p, percent := strings.CutSuffix(s, "%")
+	v, err := strconv.ParseFloat(p, 64)
+	if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0, ErrInvalidColor
+	}
+	if percent {
+		return v / 100 * scale, nil
+	}
+	return v, nil
+}
+
+// Hex возвращает цвет в канонической записи #rrggbb или #rrggbbaa, если цвет полупрозрачный.
+func (c Color) Hex() string {
+	if c.A >= 1 {
+		return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+	}
+	return fmt.Sprintf("#%02x%02x%02x%02x", c.R, c.G, c.B, uint8(math.Round(clamp01(c.A)*255)))
+}
+
+// String возвращает цвет в канонической HEX-записи.
+func (c Color) String() string {
+	return c.Hex()
+}
+
+// CSS возвращает цвет в записи rgb() или rgba().
+func (c Color) CSS() string {
+	if c.A >= 1 {
+		return fmt.Sprintf("rgb(%d, %d, %d)", c.R, c.G, c.B)
+	}
+	return fmt.Sprintf("rgba(%d, %d, %d, %s)", c.R, c.G, c.B, strconv.FormatFloat(RoundFloat(clamp01(c.A), 3), 'f', -1, 64))
+}
+
+// NormalizeHexColor приводит цвет в любом поддерживаемом формате к канонической HEX-записи.
+// При ошибке разбора возвращает пустую строку.
+func NormalizeHexColor(s string) string {
+	c, err := ParseColor(s)
+	if err != nil {
+		return ""
+	}
+	return c.Hex()
+}
+
+// HSL преобразует цвет в модель HSL.
+func (c Color) HSL() HSL {
+	r, g, b := float64(c.R)/255, float64(c.G)/255, float64(c.B)/255
+	maxC, minC := math.Max(r, math.Max(g, b)), math.Min(r, math.Min(g, b))
+	h := colorHue(r, g, b, maxC, minC)
+	l := (maxC + minC) / 2
+
+	var s float64
+	if d := maxC - minC; d != 0 {
+		s = d / (1 - math.Abs(2*l-1))
+	}
+	return HSL{H: h, S: s, L: l}
+}
+
+// HSV преобразует цвет в модель HSV.
+func (c Color) HSV() HSV {
+	r, g, b := float64(c.R)/255, float64(c.G)/255, float64(c.B)/255
+	maxC, minC := math.Max(r, math.Max(g, b)), math.Min(r, math.Min(g, b))
+
+	var s float64
+	if maxC != 0 {
+		s = (maxC - minC) / maxC
+	}
+	return HSV{H: colorHue(r, g, b, maxC, minC), S: s, V: maxC}
+}
+
+// colorHue вычисляет цветовой тон в градусах.
+func colorHue(r, g, b, maxC, minC float64) float64 {
+	d := maxC - minC
+	if d == 0 {
+		return 0
+	}
+	var h float64
+	switch maxC {
+	case r:
+		h = math.Mod((g-b)/d, 6)
+	case g:
+		h = (b-r)/d + 2
+	default:
+		h = (r-g)/d + 4
+	}
+	h *= 60
+	if h < 0 {
+		h += 360
+	}
+	return h
+}
+
+// Color преобразует HSL в непрозрачный цвет RGB.
+func (h HSL) Color() Color {
+	s, l := clamp01(h.S), clamp01(h.L)
+	chroma := (1 - math.Abs(2*l-1)) * s
+	return hueToColor(h.H, chroma, l-chroma/2)
+}
+
+// Color преобразует HSV в непрозрачный цвет RGB.
+func (h HSV) Color() Color {
+	s, v := clamp01(h.S), clamp01(h.V)
+	chroma := v * s
+	return hueToColor(h.H, chroma, v-chroma)
+}
+
+// hueToColor строит цвет по тону, хроме и смещению яркости.
+func hueToColor(hue, chroma, m float64) Color {
+	hue = math.Mod(hue, 360)
+	if hue < 0 {
+		hue += 360
+	}
+	x := chroma * (1 - math.Abs(math.Mod(hue/60, 2)-1))
+
+	var r, g, b float64
+	switch {
+	case hue < 60:
+		r, g = chroma, x
+	case hue < 120:
+		r, g = x, chroma
+	case hue < 180:
+		g, b = chroma, x
+	case hue < 240:
+		g, b = x, chroma
+	case hue < 300:
+		r, b = x, chroma
+	default:
+		r, b = chroma, x
+	}
+	return Color{R: colorByte(r + m), G: colorByte(g + m), B: colorByte(b + m), A: 1}
+}
+
+// Luminance возвращает относительную яркость цвета по WCAG 2.x (от 0 до 1).
+func (c Color) Luminance() float64 {
+	linear := func(v uint8) float64 {
+		f := float64(v) / 255
+		if f <= 0.04045 {
+			return f / 12.92
+		}
+		return math.Pow((f+0.055)/1.055, 2.4)
+	}
+	return 0.2126*linear(c.R) + 0.7152*linear(c.G) + 0.0722*linear(c.B)
+}
+
+// ContrastRatio возвращает коэффициент контрастности двух цветов по WCAG (от 1 до 21).
+// Прозрачность не учитывается.
+func ContrastRatio(a, b Color) float64 {
+	l1, l2 := a.Luminance(), b.Luminance()
+	if l1 < l2 {
+		l1, l2 = l2, l1
+	}
+	return (l1 + 0.05) / (l2 + 0.05)
+}
+
+// IsReadable проверяет, что контраст текста и фона соответствует уровню WCAG AA
+// (4.5:1 для обычного текста, 3:1 для крупного).
+func IsReadable(text, background Color, largeText bool) bool {
+	minRatio := 4.5
+	if largeText {
+		minRatio = 3
+	}
+	return ContrastRatio(text, background) >= minRatio
+}
+
+// Lighten увеличивает светлоту цвета в модели HSL на amount (от 0 до 1).
+func (c Color) Lighten(amount float64) Color {
+	h := c.HSL()
+	h.L = clamp01(h.L + amount)
+	res := h.Color()
+	res.A = c.A
+	return res
+}
+
+// Darken уменьшает светлоту цвета в модели HSL на amount (от 0 до 1).
+func (c Color) Darken(amount float64) Color {
+	return c.Lighten(-amount)
+}
+
+// Mix смешивает цвет с other; weight — доля other от 0 до 1.
+func (c Color) Mix(other Color, weight float64) Color {
+	w := clamp01(weight)
+	mix := func(a, b uint8) uint8 {
+		return colorByte((float64(a)*(1-w) + float64(b)*w) / 255)
+	}
+	return Color{
+		R: mix(c.R, other.R),
+		G: mix(c.G, other.G),
+		B: mix(c.B, other.B),
+		A: c.A*(1-w) + other.A*w,
+	}
+}
+
+// clamp01 ограничивает значение диапазоном [0, 1].
+func clamp01(v float64) float64 {
+	return math.Max(0, math.Min(1, v))
+}
+
+// colorByte переводит компоненту из [0, 1] в байт с округлением.
+func colorByte(v float64) uint8 {
+	return uint8(math.Round(clamp01(v) * 255))
+}
+
+// cssNamedColors — именованные цвета CSS Color Module Level 4.
+var cssNamedColors = func() map[string]Color {
+	named := map[string]uint32{
+		"aliceblue": 0xf0f8ff, "antiquewhite": 0xfaebd7, "aqua": 0x00ffff, "aquamarine": 0x7fffd4,
+		"azure": 0xf0ffff, "beige": 0xf5f5dc, "bisque": 0xffe4c4, "black": 0x000000,
+		"blanchedalmond": 0xffebcd, "blue": 0x0000ff, "blueviolet": 0x8a2be2, "brown": 0xa52a2a,
+		"burlywood": 0xdeb887, "cadetblue": 0x5f9ea0, "chartreuse": 0x7fff00, "chocolate": 0xd2691e,
+		"coral": 0xff7f50, "cornflowerblue": 0x6495ed, "cornsilk": 0xfff8dc, "crimson": 0xdc143c,
+		"cyan": 0x00ffff, "darkblue": 0x00008b, "darkcyan": 0x008b8b, "darkgoldenrod": 0xb8860b,
+		"darkgray": 0xa9a9a9, "darkgreen": 0x006400, "darkgrey": 0xa9a9a9, "darkkhaki": 0xbdb76b,
+		"darkmagenta": 0x8b008b, "darkolivegreen": 0x556b2f, "darkorange": 0xff8c00, "darkorchid": 0x9932cc,
+		"darkred": 0x8b0000, "darksalmon": 0xe9967a, "darkseagreen": 0x8fbc8f, "darkslateblue": 0x483d8b,
+		"darkslategray": 0x2f4f4f, "darkslategrey": 0x2f4f4f, "darkturquoise": 0x00ced1, "darkviolet": 0x9400d3,
+		"deeppink": 0xff1493, "deepskyblue": 0x00bfff, "dimgray": 0x696969, "dimgrey": 0x696969,
+		"dodgerblue": 0x1e90ff, "firebrick": 0xb22222, "floralwhite": 0xfffaf0, "forestgreen": 0x228b22,
+		"fuchsia": 0xff00ff, "gainsboro": 0xdcdcdc, "ghostwhite": 0xf8f8ff, "gold": 0xffd700,
+		"goldenrod": 0xdaa520, "gray": 0x808080, "green": 0x008000, "greenyellow": 0xadff2f,
+		"grey": 0x808080, "honeydew": 0xf0fff0, "hotpink": 0xff69b4, "indianred": 0xcd5c5c,
+		"indigo": 0x4b0082, "ivory": 0xfffff0, "khaki": 0xf0e68c, "lavender": 0xe6e6fa,
+		"lavenderblush": 0xfff0f5, "lawngreen": 0x7cfc00, "lemonchiffon": 0xfffacd, "lightblue": 0xadd8e6,
+		"lightcoral": 0xf08080, "lightcyan": 0xe0ffff, "lightgoldenrodyellow": 0xfafad2, "lightgray": 0xd3d3d3,
+		"lightgreen": 0x90ee90, "lightgrey": 0xd3d3d3, "lightpink": 0xffb6c1, "lightsalmon": 0xffa07a,
+		"lightseagreen": 0x20b2aa, "lightskyblue": 0x87cefa, "lightslategray": 0x778899, "lightslategrey": 0x778899,
+		"lightsteelblue": 0xb0c4de, "lightyellow": 0xffffe0, "lime": 0x00ff00, "limegreen": 0x32cd32,
+		"linen": 0xfaf0e6, "magenta": 0xff00ff, "maroon": 0x800000, "mediumaquamarine": 0x66cdaa,
+		"mediumblue": 0x0000cd, "mediumorchid": 0xba55d3, "mediumpurple": 0x9370db, "mediumseagreen": 0x3cb371,
+		"mediumslateblue": 0x7b68ee, "mediumspringgreen": 0x00fa9a, "mediumturquoise": 0x48d1cc, "mediumvioletred": 0xc71585,
+		"midnightblue": 0x191970, "mintcream": 0xf5fffa, "mistyrose": 0xffe4e1, "moccasin": 0xffe4b5,
+		"navajowhite": 0xffdead, "navy": 0x000080, "oldlace": 0xfdf5e6, "olive": 0x808000,
+		"olivedrab": 0x6b8e23, "orange": 0xffa500, "orangered": 0xff4500, "orchid": 0xda70d6,
+		"palegoldenrod": 0xeee8aa, "palegreen": 0x98fb98, "paleturquoise": 0xafeeee, "palevioletred": 0xdb7093,
+		"papayawhip": 0xffefd5, "peachpuff": 0xffdab9, "peru": 0xcd853f, "pink": 0xffc0cb,
+		"plum": 0xdda0dd, "powderblue": 0xb0e0e6, "purple": 0x800080, "rebeccapurple": 0x663399,
+		"red": 0xff0000, "rosybrown": 0xbc8f8f, "royalblue": 0x4169e1, "saddlebrown": 0x8b4513,
+		"salmon": 0xfa8072, "sandybrown": 0xf4a460, "seagreen": 0x2e8b57, "seashell": 0xfff5ee,
+		"sienna": 0xa0522d, "silver": 0xc0c0c0, "skyblue": 0x87ceeb, "slateblue": 0x6a5acd,
+		"slategray": 0x708090, "slategrey": 0x708090, "snow": 0xfffafa, "springgreen": 0x00ff7f,
+		"steelblue": 0x4682b4, "tan": 0xd2b48c, "teal": 0x008080, "thistle": 0xd8bfd8,
+		"tomato": 0xff6347, "turquoise": 0x40e0d0, "violet": 0xee82ee, "wheat": 0xf5deb3,
+		"white": 0xffffff, "whitesmoke": 0xf5f5f5, "yellow": 0xffff00, "yellowgreen": 0x9acd32,
+	}
+	colors := make(map[string]Color, len(named)+1)
+	for name, v := range named {
+		colors[name] = Color{R: uint8(v >> 16), G: uint8(v >> 8), B: uint8(v), A: 1}
+	}
+	colors["transparent"] = Color{}
+	return colors
+}()
@@ -0,0 +1,173 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestParseColor(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{"1", "#F00", "#ff0000", false},
+		{"2", "f00", "#ff0000", false},
+		{"3", "#f008", "#ff000088", false},
+		{"4", "#1F1F1F", "#1f1f1f", false},
+		{"5", "1F1F1F80", "#1f1f1f80", false},
+		{"6", "#1f1f1fff", "#1f1f1f", false},
+		{"7", "rgb(255, 128, 0)", "#ff8000", false},
+		{"8", "RGBA(255,128,0,0.5)", "#ff800080", false},
+		{"9", "rgb(100% 0% 0% / 25%)", "#ff000040", false},
+		{"10", "hsl(120, 100%, 50%)", "#00ff00", false},
+		{"11", "hsla(240deg 100% 50% / 0.5)", "#0000ff80", false},
+		{"12", "RebeccaPurple", "#663399", false},
+		{"13", " white ", "#ffffff", false},
+		{"14", "transparent", "#00000000", false},
+		{"15", "#12345", "", true},
+		{"16", "GGG", "", true},
+		{"17", "rgb(256, 0, 0)", "", true},
+		{"18", "rgb(1, 2)", "", true},
+		{"19", "rgba(1, 2, 3, 2)", "", true},
+		{"20", "hsl(120, 100, 50%)", "", true},
+		{"21", "hsl(x, 10%, 50%)", "", true},
+		{"22", "notacolor", "", true},
+		{"23", "", "", true},
+		{"24", "rgb(NaN, 0, 0)", "", true},
+		{"25", "rgba(0, 0, 0, nan)", "", true},
+		{"26", "hsl(inf, 10%, 50%)", "", true},
+		{"27", "hsl(0, NaN%, 50%)", "", true},
+		{"28", "##f00", "", true},
+		{"29", "#f00g", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseColor(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseColor() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if !errors.Is(err, ErrInvalidColor) {
+					t.Errorf("ParseColor() error = %v, want %v", err, ErrInvalidColor)
+				}
+				if IsColor(tt.input) {
+					t.Errorf("IsColor(%q) = true, want false", tt.input)
+				}
+				return
+			}
+			if got.Hex() != tt.want || NormalizeHexColor(tt.input) != tt.want {
+				t.Errorf("ParseColor() = %v, want %v", got.Hex(), tt.want)
+			}
+		})
+	}
+	if NormalizeHexColor("nope") != "" {
+		t.Error("NormalizeHexColor() для некорректного цвета должна возвращать пустую строку")
+	}
+}
+
+func TestColorConversions(t *testing.T) {
+	tests := []struct {
+		hex string
+		hsl HSL
+		hsv HSV
+	}{
+		{"#000000", HSL{0, 0, 0}, HSV{0, 0, 0}},
+		{"#ffffff", HSL{0, 0, 1}, HSV{0, 0, 1}},
+		{"#ff0000", HSL{0, 1, 0.5}, HSV{0, 1, 1}},
+		{"#00ff00", HSL{120, 1, 0.5}, HSV{120, 1, 1}},
+		{"#0000ff", HSL{240, 1, 0.5}, HSV{240, 1, 1}},
+		{"#ff00ff", HSL{300, 1, 0.5}, HSV{300, 1, 1}},
+		{"#808080", HSL{0, 0, 0.50196}, HSV{0, 0, 0.50196}},
+		{"#663399", HSL{270, 0.5, 0.4}, HSV{270, 0.66667, 0.6}},
+	}
+	near := func(a, b float64) bool { return math.Abs(a-b) < 1e-4 }
+	for _, tt := range tests {
+		c := MustParseColor(tt.hex)
+		hsl, hsv := c.HSL(), c.HSV()
+		if !near(hsl.H, tt.hsl.H) || !near(hsl.S, tt.hsl.S) || !near(hsl.L, tt.hsl.L) {
+			t.Errorf("%s.HSL() = %+v, want %+v", tt.hex, hsl, tt.hsl)
+		}
+		if !near(hsv.H, tt.hsv.H) || !near(hsv.S, tt.hsv.S) || !near(hsv.V, tt.hsv.V) {
+			t.Errorf("%s.HSV() = %+v, want %+v", tt.hex, hsv, tt.hsv)
+		}
+		// Обратное преобразование
+		if got := hsl.Color().Hex(); got != tt.hex {
+			t.Errorf("HSL(%+v).Color() = %s, want %s", hsl, got, tt.hex)
+		}
+		if got := hsv.Color().Hex(); got != tt.hex {
+			t.Errorf("HSV(%+v).Color() = %s, want %s", hsv, got, tt.hex)
+		}
+	}
+	if got := (HSL{H: -120, S: 1, L: 0.5}).Color().Hex(); got != "#0000ff" {
+		t.Errorf("Отрицательный тон: получено %s, want #0000ff", got)
+	}
+}
+
+func TestContrastRatio(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want float64
+	}{
+		{"#000", "#fff", 21},
+		{"#fff", "#fff", 1},
+		{"#777", "#fff", 4.48},
+		{"#595959", "#fff", 7.0},
+	}
+	for _, tt := range tests {
+		got := ContrastRatio(MustParseColor(tt.a), MustParseColor(tt.b))
+		if RoundFloat(got, 2) != tt.want && RoundFloat(got, 1) != tt.want {
+			t.Errorf("ContrastRatio(%s, %s) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+	white := RGB(255, 255, 255)
+	if IsReadable(MustParseColor("#777"), white, false) || !IsReadable(MustParseColor("#777"), white, true) {
+		t.Error("IsReadable() вернула неверный результат для #777 на белом")
+	}
+}
+
+func TestColorOperations(t *testing.T) {
+	red := MustParseColor("red")
+	if got := red.Lighten(0.2).Hex(); got != "#ff6666" {
+		t.Errorf("Lighten() = %s, want #ff6666", got)
+	}
+	if got := red.Darken(0.2).Hex(); got != "#990000" {
+		t.Errorf("Darken() = %s, want #990000", got)
+	}
+	if got := red.Lighten(2).Hex(); got != "#ffffff" {
+		t.Errorf("Lighten() = %s, want #ffffff", got)
+	}
+	if got := red.Mix(MustParseColor("blue"), 0.5).Hex(); got != "#800080" {
+		t.Errorf("Mix() = %s, want #800080", got)
+	}
+	if got := red.Mix(MustParseColor("transparent"), 0.5).Hex(); got != "#80000080" {
+		t.Errorf("Mix() = %s, want #80000080", got)
+	}
+	semi := MustParseColor("#ff000080")
+	if got := semi.Darken(0.1).A; got != semi.A {
+		t.Errorf("Darken() изменила прозрачность: %v", got)
+	}
+	if got := semi.CSS(); got != "rgba(255, 0, 0, 0.502)" {
+		t.Errorf("CSS() = %s", got)
+	}
+	if got := RGB(1, 2, 3).CSS(); got != "rgb(1, 2, 3)" {
+		t.Errorf("CSS() = %s", got)
+	}
+	if got := RGB(1, 2, 3).String(); got != "#010203" {
+		t.Errorf("String() = %s", got)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParseColor() должна паниковать на некорректном цвете")
+		}
+	}()
+	MustParseColor("nope")
+}
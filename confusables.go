@@ -0,0 +1,106 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+//go:generate go run confusables_gen.go
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Skeleton возвращает «скелет» строки по UTS #39: строки, которые выглядят одинаково,
+// имеют одинаковый скелет. Скелет служит ключом для проверки уникальности имен пользователей
+// и доменов и не предназначен для отображения: Skeleton("раураl") (с кириллическими «р», «а», «у»)
+// = Skeleton("paypa1") = "paypal", Skeleton("admin") = "adrnin". Прототипы берутся из таблицы
+// Unicode confusables.txt (см. confusables_gen.go). Как и в UTS #39, строка приводится к NFD,
+// поэтому совместимые символы (полноширинные буквы, лигатуры) сводятся к обычным, только если
+// они есть в таблице. Сравнение учитывает регистр; для ключей без учета регистра приведите
+// строку к нижнему регистру перед вызовом.
+func Skeleton(s string) string {
+	s = RemoveInvisible(norm.NFD.String(s))
+
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range s {
+		if prototype, ok := confusables[r]; ok {
+			b.WriteString(prototype)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return norm.NFD.String(b.String())
+}
+
+// IsConfusable сообщает, выглядят ли две различные строки одинаково (совпадают их скелеты).
+func IsConfusable(a, b string) bool {
+	return a != b && Skeleton(a) == Skeleton(b)
+}
+
+// scriptCandidates — письменности, проверяемые в первую очередь; остальные ищутся в unicode.Scripts.
+var scriptCandidates = []string{
+	"Latin", "Cyrillic", "Greek", "Armenian", "Georgian", "Hebrew", "Arabic",
+	"Han", "Hiragana", "Katakana", "Hangul", "Bopomofo", "Thai", "Devanagari",
+}
+
+// scriptOf возвращает название письменности руны; для общих (цифры, знаки) и унаследованных
+// (диакритика) символов возвращается пустая строка.
+func scriptOf(r rune) string {
+	if unicode.Is(unicode.Common, r) || unicode.Is(unicode.Inherited, r) {
+		return ""
+	}
+	for _, name := range scriptCandidates {
+		if unicode.Is(unicode.Scripts[name], r) {
+			return name
+		}
+	}
+	for name, table := range unicode.Scripts {
+		if unicode.Is(table, r) {
+			return name
+		}
+	}
+	return ""
+}
+
+// ScriptsOf возвращает отсортированный список письменностей Unicode ("Cyrillic", "Latin" и т.д.),
+// буквы которых встречаются в строке. Цифры, знаки препинания и диакритика не учитываются.
+func ScriptsOf(s string) []string {
+	var scripts []string
+	for _, r := range s {
+		if name := scriptOf(r); name != "" && !slices.Contains(scripts, name) {
+			scripts = append(scripts, name)
+		}
+	}
+	slices.Sort(scripts)
+	return scripts
+}
+
+// scriptCombinations — сочетания письменностей, образующие одну систему письма (UTS #39):
+// японская (кандзи и каны), корейская (ханча и хангыль) и китайская с бопомофо.
+var scriptCombinations = [][]string{
+	{"Han", "Hiragana", "Katakana"},
+	{"Han", "Hangul"},
+	{"Bopomofo", "Han"},
+}
+
+// IsMixedScript сообщает, смешаны ли в строке буквы разных письменностей, как в "раypal"
+// (кириллические «р» и «а» среди латиницы). Сочетания, принятые в японском, корейском
+// и китайском письме, смешанными не считаются.
+func IsMixedScript(s string) bool {
+	scripts := ScriptsOf(s)
+	if len(scripts) <= 1 {
+		return false
+	}
+	for _, combination := range scriptCombinations {
+		if !slices.ContainsFunc(scripts, func(name string) bool { return !slices.Contains(combination, name) }) {
+			return false
+		}
+	}
+	return true
+}
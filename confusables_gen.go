@@ -0,0 +1,160 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+//go:build ignore
+
+// Генератор таблицы confusables из файла confusables.txt (UTS #39).
+//
+// Использование:
+//
+//	go run confusables_gen.go [-input confusables.txt] [-output confusables_data.go]
+//
+// Без -input файл загружается с unicode.org для версии -version.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+func main() {
+	version := flag.String("version", "15.0.0", "версия данных Unicode security")
+	input := flag.String("input", "", "путь к confusables.txt (по умолчанию загружается с unicode.org)")
+	output := flag.String("output", "confusables_data.go", "файл с результатом")
+	flag.Parse()
+
+	var r io.Reader
+	if *input != "" {
+		f, err := os.Open(*input)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		r = f
+	} else {
+		resp, err := http.Get("https://www.unicode.org/Public/security/" + *version + "/confusables.txt")
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			log.Fatalf("загрузка confusables.txt: %s", resp.Status)
+		}
+		r = resp.Body
+	}
+
+	table := make(map[rune]string)
+	fileVersion := *version
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimPrefix(scanner.Text(), "\ufeff")
+		if v, ok := strings.CutPrefix(line, "# Version: "); ok {
+			fileVersion = strings.TrimSpace(v)
+			continue
+		}
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		// Формат: источник ; прототип ; MA # комментарий
+		fields := strings.Split(line, ";")
+		if len(fields) < 3 {
+			log.Fatalf("некорректная строка: %q", line)
+		}
+		source := []rune(parseCodePoints(fields[0]))
+		if len(source) != 1 {
+			log.Fatalf("источник должен быть одной кодовой точкой: %q", line)
+		}
+
+		// Skeleton ищет символы после NFD: остальные источники в строке не встречаются
+		if !norm.NFD.IsNormalString(string(source)) {
+			continue
+		}
+		table[source[0]] = parseCodePoints(fields[1])
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Прототипы повторно нормализуются и отображаются, пока не перестанут меняться:
+	// так скелет не зависит от того, применялась ли таблица уже к части строки
+	closed := make(map[rune]string, len(table))
+	for src, prototype := range table {
+		for range 4 {
+			next := mapConfusables(table, norm.NFD.String(prototype))
+			if next == prototype {
+				break
+			}
+			prototype = next
+		}
+		closed[src] = norm.NFD.String(prototype)
+	}
+
+	keys := make([]rune, 0, len(closed))
+	for src := range closed {
+		keys = append(keys, src)
+	}
+	slices.Sort(keys)
+
+	var buf bytes.Buffer
+	buf.WriteString("// Copyright 2023-2025, Appercase LLC. All rights reserved.\n")
+	buf.WriteString("// https://www.appercase.ru/\n//\n// v1.2.3\n\n")
+	buf.WriteString("// Code generated by confusables_gen.go; DO NOT EDIT.\n\n")
+	buf.WriteString("package helpers\n\n")
+	buf.WriteString("// confusablesVersion — версия Unicode confusables.txt (UTS #39), из которой сгенерирована таблица.\n")
+	fmt.Fprintf(&buf, "const confusablesVersion = %q\n\n", fileVersion)
+	buf.WriteString("// confusables — символы и их прототипы из confusables.txt для символов, устойчивых к NFD.\n")
+	buf.WriteString("var confusables = map[rune]string{\n")
+	for _, src := range keys {
+		fmt.Fprintf(&buf, "0x%04X: %s,\n", src, strconv.QuoteToASCII(closed[src]))
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parseCodePoints разбирает последовательность шестнадцатеричных кодовых точек через пробел.
+func parseCodePoints(s string) string {
+	var b strings.Builder
+	for _, code := range strings.Fields(s) {
+		n, err := strconv.ParseUint(code, 16, 32)
+		if err != nil {
+			log.Fatalf("некорректная кодовая точка %q: %v", code, err)
+		}
+		b.WriteRune(rune(n))
+	}
+	return b.String()
+}
+
+// mapConfusables заменяет каждый символ строки его прототипом из таблицы.
+func mapConfusables(table map[rune]string, s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if prototype, ok := table[r]; ok {
+			b.WriteString(prototype)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
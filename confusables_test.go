@@ -0,0 +1,94 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSkeleton(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"Кириллическая а", "аdmin", "adrnin"},
+		{"Цифры вместо букв", "paypa1", "paypal"},
+		{"Кириллица целиком", "раураl", "paypal"},
+		{"Греческие заглавные", "ΑΒΕΤ", "ABET"},
+		{"Полноширинные: NFD не раскладывает совместимые символы", "\uff41\uff44\uff4d\uff49\uff4e", "a\uff44\uff4di\uff4e"},
+		{"Составная буква", "caf\u00e9", "cafe\u0301"},
+		{"Разложенная буква", "cafe\u0301", "cafe\u0301"},
+		{"Невидимые символы", "ad\u200bmin", "adrnin"},
+		{"Заглавная I", "Iogin", "login"},
+		{"Буквы rn", "rnodern", "rnodern"},
+		{"Армянская о", "g\u0585\u0585gle", "google"},
+		{"Знак процента", "%", "\u00ba/\u2080"},
+		{"Латиница без изменений", "login", "login"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Skeleton(tt.input); got != tt.expected {
+				t.Errorf("Skeleton(%q) = %q; ожидается %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIsConfusable(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected bool
+	}{
+		{"admin", "аdmin", true},
+		{"modern", "rnodern", true},
+		{"paypal", "paypa1", true},
+		{"admin", "admin", false},
+		{"admin", "root", false},
+		{"привет", "привет", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"_"+tt.b, func(t *testing.T) {
+			if got := IsConfusable(tt.a, tt.b); got != tt.expected {
+				t.Errorf("IsConfusable(%q, %q) = %v; ожидается %v", tt.a, tt.b, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestScriptsOf(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []string
+		mixed    bool
+	}{
+		{"admin", []string{"Latin"}, false},
+		{"аdmin", []string{"Cyrillic", "Latin"}, true},
+		{"Привет, мир! 123", []string{"Cyrillic"}, false},
+		{"ещё", []string{"Cyrillic"}, false},
+		{"Αlpha", []string{"Greek", "Latin"}, true},
+		{"東京タワー", []string{"Han", "Katakana"}, false},
+		{"ひらがなとカタカナと漢字", []string{"Han", "Hiragana", "Katakana"}, false},
+		{"한국漢字", []string{"Han", "Hangul"}, false},
+		{"漢字abc", []string{"Han", "Latin"}, true},
+		{"123 !?", nil, false},
+		{"", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := ScriptsOf(tt.input); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("ScriptsOf(%q) = %v; ожидается %v", tt.input, got, tt.expected)
+			}
+			if got := IsMixedScript(tt.input); got != tt.mixed {
+				t.Errorf("IsMixedScript(%q) = %v; ожидается %v", tt.input, got, tt.mixed)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+//go:generate go run emoji_gen.go
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// EmojiMatch — эмодзи, найденный в строке.
+type EmojiMatch struct {
+	Emoji string // последовательность в том виде, в каком она записана в строке
+	Name  string // краткое имя CLDR: "thumbs up: medium skin tone"
+	Start int    // смещение начала в байтах
+	End   int    // смещение конца в байтах
+}
+
+// emojiIndex — индексы таблицы emojiTable.
+type emojiIndex struct {
+	names      map[string]string // полностью квалифицированная последовательность → имя
+	canonical  map[string]string // последовательность без U+FE0F → полностью квалифицированная
+	shortcodes map[string]string // полностью квалифицированная последовательность → код без двоеточий
+	emojis     map[string]string // код без двоеточий → полностью квалифицированная последовательность
+}
+
+var (
+	// emojiShortcodePattern — код эмодзи вида ":thumbs_up:".
+	emojiShortcodePattern = regexp.MustCompile(`:[a-z0-9_]+:`)
+
+	// emojiIndexOnce строит индексы таблицы эмодзи при первом обращении.
+	emojiIndexOnce = sync.OnceValue(func() *emojiIndex {
+		idx := &emojiIndex{
+			names:      make(map[string]string, len(emojiTable)),
+			canonical:  make(map[string]string, len(emojiTable)),
+			shortcodes: make(map[string]string, len(emojiTable)),
+			emojis:     make(map[string]string, len(emojiTable)),
+		}
+		for _, e := range emojiTable {
+			idx.names[e.seq] = e.name
+			idx.canonical[strings.ReplaceAll(e.seq, "\ufe0f", "")] = e.seq
+
+			code := emojiShortcodeFromName(e.name)
+			if _, ok := idx.emojis[code]; !ok {
+				idx.emojis[code] = e.seq
+				idx.shortcodes[e.seq] = code
+			}
+		}
+		return idx
+	})
+)
+
+// emojiShortcodeFromName строит код эмодзи из краткого имени CLDR:
+// "thumbs up: medium skin tone" → "thumbs_up_medium_skin_tone".
+func emojiShortcodeFromName(name string) string {
+	name = strings.NewReplacer("#", " number sign ", "*", " asterisk ", "&", " and ").Replace(name)
+	return strings.ReplaceAll(slugWords(name), "-", "_")
+}
+
+// lookupEmoji возвращает полностью квалифицированную форму графемного кластера, если он является эмодзи.
+// Последовательности без селектора U+FE0F (минимально квалифицированные) также распознаются,
+// а одиночные символы без селектора, по умолчанию отображаемые как текст (©, ™, ❤), — нет.
+func (idx *emojiIndex) lookupEmoji(cluster string) (string, bool) {
+	if _, ok := idx.names[cluster]; ok {
+		return cluster, true
+	}
+	stripped := strings.ReplaceAll(cluster, "\ufe0f", "")
+	seq, ok := idx.canonical[stripped]
+	if !ok {
+		return "", false
+	}
+	if stripped != cluster || utf8.RuneCountInString(stripped) > 1 {
+		return seq, true
+	}
+	return "", false
+}
+
+// FindEmojis возвращает все эмодзи строки: последовательности ZWJ, эмодзи с оттенками кожи,
+// флаги, клавиши ("1️⃣") и одиночные пиктограммы распознаются как единое целое
+// по данным Unicode emoji (emoji-test.txt).
+func FindEmojis(s string) []EmojiMatch {
+	idx := emojiIndexOnce()
+	var matches []EmojiMatch
+	pos := 0
+	for cluster := range GraphemeSeq(s) {
+		if seq, ok := idx.lookupEmoji(cluster); ok {
+			matches = append(matches, EmojiMatch{Emoji: cluster, Name: idx.names[seq], Start: pos, End: pos + len(cluster)})
+		}
+		pos += len(cluster)
+	}
+	return matches
+}
+
+// IsEmoji сообщает, является ли строка ровно одним эмодзи.
+func IsEmoji(s string) bool {
+	_, ok := emojiIndexOnce().lookupEmoji(s)
+	return ok && graphemeClusterLen(s) == len(s)
+}
+
+// ContainsEmoji сообщает, содержит ли строка хотя бы один эмодзи.
+func ContainsEmoji(s string) bool {
+	idx := emojiIndexOnce()
+	for cluster := range GraphemeSeq(s) {
+		if _, ok := idx.lookupEmoji(cluster); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// CountEmojis возвращает число эмодзи в строке; "👨‍👩‍👧" и "🇷🇺" считаются одним эмодзи.
+func CountEmojis(s string) int {
+	return len(FindEmojis(s))
+}
+
+// ExtractEmojis возвращает эмодзи строки в порядке следования.
+func ExtractEmojis(s string) []string {
+	var emojis []string
+	for _, m := range FindEmojis(s) {
+		emojis = append(emojis, m.Emoji)
+	}
+	return emojis
+}
+
+// ReplaceEmojis заменяет каждый эмодзи строки результатом функции fn.
+func ReplaceEmojis(s string, fn func(emoji string) string) string {
+	idx := emojiIndexOnce()
+	var b strings.Builder
+	b.Grow(len(s))
+	for cluster := range GraphemeSeq(s) {
+		if _, ok := idx.lookupEmoji(cluster); ok {
+			b.WriteString(fn(cluster))
+		} else {
+			b.WriteString(cluster)
+		}
+	}
+	return b.String()
+}
+
+// StripEmojis удаляет из строки эмодзи целиком, не затрагивая остальной текст и пробелы.
+func StripEmojis(s string) string {
+	return ReplaceEmojis(s, func(string) string { return "" })
+}
+
+// EmojiName возвращает краткое имя эмодзи по CLDR ("grinning face") или пустую строку.
+func EmojiName(emoji string) string {
+	idx := emojiIndexOnce()
+	seq, ok := idx.lookupEmoji(emoji)
+	if !ok {
+		return ""
+	}
+	return idx.names[seq]
+}
+
+// EmojiShortcode возвращает код эмодзи вида ":grinning_face:", построенный из имени CLDR,
+// или пустую строку, если emoji не является эмодзи.
+func EmojiShortcode(emoji string) string {
+	idx := emojiIndexOnce()
+	seq, ok := idx.lookupEmoji(emoji)
+	if !ok {
+		return ""
+	}
+	return ":" + idx.shortcodes[seq] + ":"
+}
+
+// EmojisToShortcodes заменяет эмодзи строки кодами: "Привет 👋" → "Привет :waving_hand:".
+func EmojisToShortcodes(s string) string {
+	return ReplaceEmojis(s, EmojiShortcode)
+}
+
+// ShortcodesToEmojis заменяет известные коды эмодзи на сами эмодзи; неизвестные коды не изменяются.
+func ShortcodesToEmojis(s string) string {
+	idx := emojiIndexOnce()
+	return emojiShortcodePattern.ReplaceAllStringFunc(s, func(code string) string {
+		if seq, ok := idx.emojis[strings.Trim(code, ":")]; ok {
+			return seq
+		}
+		return code
+	})
+}
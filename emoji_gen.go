@@ -0,0 +1,123 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+//go:build ignore
+
+// Генератор таблиц эмодзи из файла emoji-test.txt (UTS #51).
+//
+// Использование:
+//
+//	go run emoji_gen.go [-input emoji-test.txt] [-output emoji_data.go]
+//
+// Без -input файл загружается с unicode.org для версии -version.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	version := flag.String("version", "15.1", "версия Unicode emoji")
+	input := flag.String("input", "", "путь к emoji-test.txt (по умолчанию загружается с unicode.org)")
+	output := flag.String("output", "emoji_data.go", "файл с результатом")
+	flag.Parse()
+
+	var r io.Reader
+	if *input != "" {
+		f, err := os.Open(*input)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		r = f
+	} else {
+		resp, err := http.Get("https://unicode.org/Public/emoji/" + *version + "/emoji-test.txt")
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			log.Fatalf("загрузка emoji-test.txt: %s", resp.Status)
+		}
+		r = resp.Body
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Copyright 2023-2025, Appercase LLC. All rights reserved.\n")
+	buf.WriteString("// https://www.appercase.ru/\n//\n// v1.2.3\n\n")
+	buf.WriteString("// Code generated by emoji_gen.go; DO NOT EDIT.\n\n")
+	buf.WriteString("package helpers\n\n")
+
+	var entries []string
+	fileVersion := *version
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if v, ok := strings.CutPrefix(line, "# Version: "); ok {
+			fileVersion = strings.TrimSpace(v)
+			continue
+		}
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		// Формат: кодовые точки ; статус # эмодзи E<версия> имя
+		fields, comment, ok := strings.Cut(line, "#")
+		if !ok {
+			continue
+		}
+		codes, status, _ := strings.Cut(fields, ";")
+		status = strings.TrimSpace(status)
+		if status != "fully-qualified" && status != "component" {
+			continue
+		}
+
+		var seq strings.Builder
+		for _, code := range strings.Fields(codes) {
+			n, err := strconv.ParseUint(code, 16, 32)
+			if err != nil {
+				log.Fatalf("некорректная кодовая точка %q: %v", code, err)
+			}
+			seq.WriteRune(rune(n))
+		}
+
+		// Комментарий: "😀 E1.0 grinning face"
+		parts := strings.SplitN(strings.TrimSpace(comment), " ", 3)
+		if len(parts) != 3 {
+			log.Fatalf("некорректный комментарий: %q", comment)
+		}
+		entries = append(entries, fmt.Sprintf("{%s, %q},", strconv.QuoteToASCII(seq.String()), parts[2]))
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Fprintf(&buf, "// emojiVersion — версия Unicode emoji, из которой сгенерированы таблицы.\n")
+	fmt.Fprintf(&buf, "const emojiVersion = %q\n\n", fileVersion)
+	buf.WriteString("// emojiTable — полностью квалифицированные эмодзи и компоненты в порядке CLDR с краткими именами.\n")
+	buf.WriteString("var emojiTable = [...]struct{ seq, name string }{\n")
+	for _, entry := range entries {
+		buf.WriteString(entry + "\n")
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestFindEmojis(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want []string
+	}{
+		{"Простые", "Привет 😀 мир 🚀", []string{"😀", "🚀"}},
+		{"Оттенок кожи", "ok 👍🏽", []string{"👍🏽"}},
+		{"ZWJ-последовательность", "семья 👨‍👩‍👧‍👦!", []string{"👨‍👩‍👧‍👦"}},
+		{"Флаги", "🇷🇺🇰🇿", []string{"🇷🇺", "🇰🇿"}},
+		{"Клавиша", "нажмите 1️⃣ или #️⃣", []string{"1️⃣", "#️⃣"}},
+		{"Звезда", "рейтинг ⭐⭐", []string{"⭐", "⭐"}},
+		{"Новые пиктограммы", "🫠🪿", []string{"🫠", "🪿"}},
+		{"С селектором", "❤️ и ©️", []string{"❤️", "©️"}},
+		{"Без селектора", "минимально 👁‍🗨", []string{"👁‍🗨"}},
+		{"Текстовое представление", "© ™ ❤ ✓ ☐ → 1 #", nil},
+		{"Одиночный региональный индикатор", "🇷", nil},
+		{"Без эмодзи", "Просто текст", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExtractEmojis(tt.s)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ExtractEmojis() = %q, want %q", got, tt.want)
+			}
+			if n := CountEmojis(tt.s); n != len(tt.want) {
+				t.Errorf("CountEmojis() = %d, want %d", n, len(tt.want))
+			}
+			if ContainsEmoji(tt.s) != (len(tt.want) > 0) {
+				t.Errorf("ContainsEmoji() = %v", !(len(tt.want) > 0))
+			}
+		})
+	}
+
+	matches := FindEmojis("a👍🏽b")
+	if len(matches) != 1 || matches[0].Start != 1 || matches[0].End != 9 || matches[0].Name != "thumbs up: medium skin tone" {
+		t.Errorf("FindEmojis() = %+v", matches)
+	}
+}
+
+func TestIsEmoji(t *testing.T) {
+	tests := []struct {
+		s    string
+		want bool
+	}{
+		{"😀", true},
+		{"👨‍💻", true},
+		{"🇷🇺", true},
+		{"🏽", true},
+		{"😀😀", false},
+		{"😀a", false},
+		{"a", false},
+		{"©", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		if got := IsEmoji(tt.s); got != tt.want {
+			t.Errorf("IsEmoji(%q) = %v, want %v", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestStripEmojis(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{"Привет 👋🏻 мир", "Привет  мир"},
+		{"👨‍👩‍👧семья", "семья"},
+		{"Флаг🇷🇺!", "Флаг!"},
+		{"✓ готово ✔️", "✓ готово "},
+		{"→ далее", "→ далее"},
+	}
+	for _, tt := range tests {
+		if got := StripEmojis(tt.s); got != tt.want {
+			t.Errorf("StripEmojis(%q) = %q, want %q", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestEmojiShortcodes(t *testing.T) {
+	tests := []struct {
+		emoji string
+		name  string
+		code  string
+	}{
+		{"😀", "grinning face", ":grinning_face:"},
+		{"👍🏽", "thumbs up: medium skin tone", ":thumbs_up_medium_skin_tone:"},
+		{"🇷🇺", "flag: Russia", ":flag_russia:"},
+		{"#️⃣", "keycap: #", ":keycap_number_sign:"},
+		{"*️⃣", "keycap: *", ":keycap_asterisk:"},
+		{"🇨🇮", "flag: Côte d’Ivoire", ":flag_cote_divoire:"},
+		{"❤", "", ""},
+	}
+	for _, tt := range tests {
+		if got := EmojiName(tt.emoji); got != tt.name {
+			t.Errorf("EmojiName(%q) = %q, want %q", tt.emoji, got, tt.name)
+		}
+		if got := EmojiShortcode(tt.emoji); got != tt.code {
+			t.Errorf("EmojiShortcode(%q) = %q, want %q", tt.emoji, got, tt.code)
+		}
+	}
+
+	if got := EmojisToShortcodes("Привет 👋 🇷🇺"); got != "Привет :waving_hand: :flag_russia:" {
+		t.Errorf("EmojisToShortcodes() = %q", got)
+	}
+	if got := ShortcodesToEmojis("Привет :waving_hand: :unknown: 12:30:00"); got != "Привет 👋 :unknown: 12:30:00" {
+		t.Errorf("ShortcodesToEmojis() = %q", got)
+	}
+
+	// Коды всех эмодзи таблицы уникальны и обратимы
+	for _, e := range emojiTable {
+		code := EmojiShortcode(e.seq)
+		if code == "::" || ShortcodesToEmojis(code) != e.seq {
+			t.Errorf("EmojiShortcode(%q) = %q: код не уникален", e.seq, code)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// ErrFilenameExhausted возвращается, если UniqueFilename не нашла свободное имя за отведенное число попыток.
+var ErrFilenameExhausted = errors.New("helpers: не удалось подобрать свободное имя файла")
+
+const (
+	// DefaultMaxFilenameBytes — ограничение длины имени файла в большинстве файловых систем.
+	DefaultMaxFilenameBytes = 255
+
+	// defaultSafeFilename — имя, используемое, если после очистки ничего не осталось.
+	defaultSafeFilename = "file"
+
+	// maxFilenameExtBytes — максимальная длина расширения, сохраняемого при обрезке.
+	maxFilenameExtBytes = 16
+
+	// uniqueFilenameMaxAttempts — максимальное число проверок при подборе свободного имени.
+	uniqueFilenameMaxAttempts = 10000
+)
+
+// filenameForbiddenChars — символы, запрещенные в именах файлов Windows, macOS или Linux.
+const filenameForbiddenChars = `<>:"/\|?*`
+
+// windowsReservedNames — имена устройств Windows, недопустимые с любым расширением.
+var windowsReservedNames = map[string]bool{
+	"CON": true, "PRN": true, "AUX": true, "NUL": true, "CONIN$": true, "CONOUT$": true,
+}
+
+// SafeFilename возвращает имя файла, безопасное для сохранения на диск в Windows, macOS и Linux:
+//   - отбрасывает компоненты пути (в том числе "..") и оставляет только последнее имя;
+//   - удаляет управляющие символы и NUL, невидимые символы и символы управления направлением
+//     текста (U+202E и др., см. RemoveInvisible), заменяет запрещенные символы на "_";
+//   - удаляет точки и пробелы в начале и в конце (скрытые файлы не создаются);
+//   - добавляет "_" к зарезервированным именам Windows (CON, NUL, COM1, LPT1 и т.д.);
+//   - ограничивает длину maxBytes байтами UTF-8, сохраняя расширение (по умолчанию 255).
+//
+// Если после очистки имя пустое, возвращается "file".
+func SafeFilename(name string, maxBytes int) string {
+	if maxBytes <= 0 {
+		maxBytes = DefaultMaxFilenameBytes
+	}
+
+	// Оставляем только последний компонент пути с любым разделителем
+	name = strings.ReplaceAll(name, `\`, "/")
+	if i := strings.LastIndexByte(name, '/'); i >= 0 {
+		name = name[i+1:]
+	}
+
+	name = RemoveInvisible(norm.NFC.String(strings.ToValidUTF8(name, "")))
+	name = strings.Map(func(r rune) rune {
+		switch {
+		case unicode.IsSpace(r):
+			return ' '
+		case strings.ContainsRune(filenameForbiddenChars, r):
+			return '_'
+		}
+		return r
+	}, name)
+	name = trimFilename(name)
+
+	stem, ext := splitFilenameExt(name)
+	return limitFilename(stem, ext, maxBytes)
+}
+
+// UniqueFilename очищает имя файла (см. SafeFilename) и подбирает имя, отсутствующее в каталоге dir,
+// добавляя к основе имени счетчик: "report.pdf", "report_1.pdf", "report_2.pdf" и т.д.
+// Проверка не атомарна: для защиты от гонок файл следует создавать с флагом os.O_EXCL.
+func UniqueFilename(dir, name string, maxBytes int) (string, error) {
+	if maxBytes <= 0 {
+		maxBytes = DefaultMaxFilenameBytes
+	}
+	name = SafeFilename(name, maxBytes)
+	stem, ext := splitFilenameExt(name)
+
+	candidate := name
+	for n := 1; n <= uniqueFilenameMaxAttempts; n++ {
+		_, err := os.Lstat(filepath.Join(dir, candidate))
+		if errors.Is(err, fs.ErrNotExist) {
+			return candidate, nil
+		}
+		if err != nil {
+			return "", err
+		}
+		candidate = limitFilename(stem, "_"+strconv.Itoa(n)+ext, maxBytes)
+	}
+	return "", ErrFilenameExhausted
+}
+
+// trimFilename удаляет пробелы и точки в начале и в конце имени.
+func trimFilename(name string) string {
+	return strings.Trim(name, " .")
+}
+
+// splitFilenameExt разделяет имя на основу и расширение (с точкой).
+// Слишком длинное расширение считается частью основы.
+func splitFilenameExt(name string) (string, string) {
+	ext := filepath.Ext(name)
+	if ext == name || len(ext) > maxFilenameExtBytes || strings.ContainsRune(ext, ' ') {
+		return name, ""
+	}
+	return name[:len(name)-len(ext)], ext
+}
+
+// limitFilename обрезает основу имени по границе символа так, чтобы вместе с суффиксом
+// имя не превышало maxBytes байт. Проверки выполняются для уже обрезанной основы:
+// пустая основа заменяется на "file", к зарезервированным именам Windows добавляется "_".
+func limitFilename(stem, suffix string, maxBytes int) string {
+	if len(suffix) >= maxBytes {
+		suffix = ""
+	}
+	limit := maxBytes - len(suffix)
+	stem = truncateFilenameStem(stem, limit)
+	if stem == "" {
+		stem = truncateFilenameStem(defaultSafeFilename, limit)
+	}
+	if isWindowsReservedName(stem) {
+		stem = truncateFilenameStem("_"+stem, limit)
+	}
+	return stem + suffix
+}
+
+// truncateFilenameStem обрезает основу имени до limit байт по границе символа
+// и удаляет точки и пробелы в конце.
+func truncateFilenameStem(stem string, limit int) string {
+	if len(stem) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(stem[cut]) {
+			cut--
+		}
+		stem = stem[:cut]
+	}
+	return strings.TrimRight(stem, " .")
+}
+
+// isWindowsReservedName сообщает, совпадает ли основа имени с именем устройства Windows.
+// Windows игнорирует все, что следует за первой точкой, а также пробелы в конце.
+func isWindowsReservedName(stem string) bool {
+	base, _, _ := strings.Cut(stem, ".")
+	base = strings.ToUpper(strings.TrimRight(base, " "))
+	if windowsReservedNames[base] {
+		return true
+	}
+	if len(base) >= 4 && (strings.HasPrefix(base, "COM") || strings.HasPrefix(base, "LPT")) {
+		rest := base[3:]
+		return (len(rest) == 1 && rest[0] >= '0' && rest[0] <= '9') || rest == "¹" || rest == "²" || rest == "³"
+	}
+	return false
+}
@@ -0,0 +1,85 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSafeFilename(t *testing.T) {
+	tests := []struct {
+		name     string
+		filename string
+		maxBytes int
+		want     string
+	}{
+		{"Обычное имя", "Отчёт за май.pdf", 0, "Отчёт за май.pdf"},
+		{"Путь Unix", "../../etc/passwd", 0, "passwd"},
+		{"Путь Windows", `C:\Users\admin\photo.jpg`, 0, "photo.jpg"},
+		{"Только точки", "..", 0, "file"},
+		{"Запрещенные символы", `a<b>c:d"e|f?g*h.txt`, 0, "a_b_c_d_e_f_g_h.txt"},
+		{"Управляющие символы", "a\x00b\x1fc\td.txt", 0, "abc d.txt"},
+		{"Точки и пробелы в конце", " report.pdf. . ", 0, "report.pdf"},
+		{"Скрытый файл", ".htaccess", 0, "htaccess"},
+		{"Зарезервированное имя", "CON.txt", 0, "_CON.txt"},
+		{"Зарезервированное имя в нижнем регистре", "lpt1.tar.gz", 0, "_lpt1.tar.gz"},
+		{"Зарезервированное имя с пробелом", "nul .txt", 0, "_nul.txt"},
+		{"Не зарезервированное имя", "CONSOLE.txt", 0, "CONSOLE.txt"},
+		{"Пустое имя", "", 0, "file"},
+		{"Только расширение", "/tmp/.pdf", 0, "pdf"},
+		{"Ограничение длины", strings.Repeat("я", 10) + ".pdf", 12, "яяяя.pdf"},
+		{"Граница символа", "aяяя.txt", 8, "aя.txt"},
+		{"Длинное расширение", "archive.verylongextension123", 10, "archive.ve"},
+		{"NFC", "e\u0301.txt", 0, "\u00e9.txt"},
+		{"Некорректный UTF-8", "a\xffb.txt", 0, "ab.txt"},
+		{"Переворот направления", "photo\u202egnp.exe", 0, "photognp.exe"},
+		{"Изоляция направления и невидимые", "\u2066a\u2069b\u200b\ufeff.txt", 0, "ab.txt"},
+		{"Зарезервированное имя после обрезки", "CONxxxxxx.txt", 7, "_CO.txt"},
+		{"Пустая основа после обрезки", "\U0001f600\U0001f600.txt", 6, "fi.txt"},
+		{"Эмодзи сохраняются", "\u2764\ufe0f.png", 0, "\u2764\ufe0f.png"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SafeFilename(tt.filename, tt.maxBytes); got != tt.want {
+				t.Errorf("SafeFilename() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUniqueFilename(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"report.pdf", "report_1.pdf", "data", "CONx.txt", "\U0001f600.txt"} {
+		if err := os.WriteFile(filepath.Join(dir, name), nil, 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		filename string
+		maxBytes int
+		want     string
+	}{
+		{"new.pdf", 0, "new.pdf"},
+		{"../report.pdf", 0, "report_2.pdf"},
+		{"data", 0, "data_1"},
+		{"report.pdf", 11, "repor_1.pdf"},
+		{"CONx.txt", 9, "_CO_1.txt"},
+		{"\U0001f600.txt", 9, "fil_1.txt"},
+	}
+	for _, tt := range tests {
+		got, err := UniqueFilename(dir, tt.filename, tt.maxBytes)
+		if err != nil {
+			t.Fatalf("UniqueFilename(%q) error = %v", tt.filename, err)
+		}
+		if got != tt.want {
+			t.Errorf("UniqueFilename(%q) = %q, want %q", tt.filename, got, tt.want)
+		}
+	}
+}
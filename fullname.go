@@ -0,0 +1,405 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+)
+
+// ErrInvalidFullName возвращается, если строка пуста или содержит больше трех слов.
+var ErrInvalidFullName = errors.New("helpers: не удалось разобрать ФИО")
+
+// PersonGender — пол человека, определенный по ФИО.
+type PersonGender int
+
+const (
+	PersonGenderUnknown PersonGender = iota // пол не удалось определить
+	PersonGenderMale                        // мужской
+	PersonGenderFemale                      // женский
+)
+
+// FullName — разобранные фамилия, имя и отчество.
+type FullName struct {
+	Surname    string
+	Name       string
+	Patronymic string
+	Gender     PersonGender
+}
+
+// nameRule — правило склонения по окончанию: cut букв отбрасывается,
+// затем добавляется окончание падежа. Правило без окончаний означает несклоняемое слово.
+type nameRule struct {
+	suffixes []string
+	cut      int
+	endings  [6]string
+}
+
+// Окончания, общие для нескольких групп правил.
+var (
+	ruEndingsHard    = [6]string{"", "а", "у", "а", "ом", "е"}
+	ruEndingsSoft    = [6]string{"", "я", "ю", "я", "ем", "е"}
+	ruEndingsSibil   = [6]string{"", "а", "у", "а", "ем", "е"}
+	ruEndingsA       = [6]string{"", "ы", "е", "у", "ой", "е"}
+	ruEndingsGKHA    = [6]string{"", "и", "е", "у", "ой", "е"}
+	ruEndingsSibilA  = [6]string{"", "и", "е", "у", "ей", "е"}
+	ruEndingsTsA     = [6]string{"", "ы", "е", "у", "ей", "е"}
+	ruEndingsYa      = [6]string{"", "и", "е", "ю", "ей", "е"}
+	ruEndingsIya     = [6]string{"", "и", "и", "ю", "ей", "и"}
+	ruEndingsOv      = [6]string{"", "а", "у", "а", "ым", "е"}
+	ruEndingsOva     = [6]string{"", "ой", "ой", "у", "ой", "ой"}
+	ruEndingsAdj     = [6]string{"", "ого", "ому", "ого", "ым", "ом"}
+	ruEndingsAdjK    = [6]string{"", "ого", "ому", "ого", "им", "ом"}
+	ruEndingsAdjFem  = [6]string{"", "ой", "ой", "ую", "ой", "ой"}
+	ruIndeclinable   = [6]string{}
+	ruSibilantSuffix = []string{"жа", "ша", "ча", "ща"}
+	ruGKHSuffix      = []string{"га", "ка", "ха"}
+	ruHardConsonants = []string{"б", "в", "г", "д", "з", "к", "л", "м", "н", "п", "р", "с", "т", "ф", "х"}
+)
+
+var (
+	ruPatronymicRules = []nameRule{
+		{[]string{"ильич", "кузьмич", "фомич", "лукич"}, 0, [6]string{"", "а", "у", "а", "ом", "е"}},
+		{[]string{"ич"}, 0, [6]string{"", "а", "у", "а", "ем", "е"}},
+		{[]string{"на"}, 1, [6]string{"", "ы", "е", "у", "ой", "е"}},
+	}
+
+	ruMaleSurnameRules = []nameRule{
+		{[]string{"ых", "их", "ко", "о", "е", "и", "у", "ю", "э", "ы"}, 0, ruIndeclinable},
+		{[]string{"ский", "цкий", "кий", "гий", "хий"}, 2, ruEndingsAdjK},
+		{[]string{"ый", "ой"}, 2, ruEndingsAdj},
+		{[]string{"ов", "ев", "ёв", "ин", "ын"}, 0, ruEndingsOv},
+		{[]string{"ия"}, 1, ruEndingsIya},
+		{[]string{"я"}, 1, ruEndingsYa},
+		{ruSibilantSuffix, 1, ruEndingsSibilA},
+		{[]string{"ца"}, 1, ruEndingsTsA},
+		{ruGKHSuffix, 1, ruEndingsGKHA},
+		{[]string{"а"}, 1, ruEndingsA},
+		{[]string{"ь", "й"}, 1, ruEndingsSoft},
+		{[]string{"ж", "ш", "ч", "щ", "ц"}, 0, ruEndingsSibil},
+		{ruHardConsonants, 0, ruEndingsHard},
+	}
+
+	ruFemaleSurnameRules = []nameRule{
+		{[]string{"ых", "их"}, 0, ruIndeclinable},
+		{[]string{"ова", "ева", "ёва", "ина", "ына"}, 1, ruEndingsOva},
+		{[]string{"ая"}, 2, ruEndingsAdjFem},
+		{[]string{"яя"}, 2, [6]string{"", "ей", "ей", "юю", "ей", "ей"}},
+		{[]string{"ия"}, 1, ruEndingsIya},
+		{[]string{"я"}, 1, ruEndingsYa},
+		{ruSibilantSuffix, 1, ruEndingsSibilA},
+		{[]string{"ца"}, 1, ruEndingsTsA},
+		{ruGKHSuffix, 1, ruEndingsGKHA},
+		{[]string{"а"}, 1, ruEndingsA},
+	}
+
+	ruMaleNameRules = []nameRule{
+		{[]string{"ий"}, 1, [6]string{"", "я", "ю", "я", "ем", "и"}},
+		{[]string{"ь", "й"}, 1, ruEndingsSoft},
+		{[]string{"ия"}, 1, ruEndingsIya},
+		{[]string{"ья"}, 1, [6]string{"", "и", "е", "ю", "ёй", "е"}},
+		{[]string{"я"}, 1, ruEndingsYa},
+		{ruSibilantSuffix, 1, ruEndingsSibilA},
+		{[]string{"ца"}, 1, ruEndingsTsA},
+		{ruGKHSuffix, 1, ruEndingsGKHA},
+		{[]string{"а"}, 1, ruEndingsA},
+		{[]string{"ж", "ш", "ч", "щ", "ц"}, 0, ruEndingsSibil},
+		{ruHardConsonants, 0, ruEndingsHard},
+	}
+
+	ruFemaleNameRules = []nameRule{
+		{[]string{"ия"}, 1, ruEndingsIya},
+		{[]string{"я"}, 1, ruEndingsYa},
+		{ruSibilantSuffix, 1, ruEndingsSibilA},
+		{[]string{"ца"}, 1, ruEndingsTsA},
+		{ruGKHSuffix, 1, ruEndingsGKHA},
+		{[]string{"а"}, 1, ruEndingsA},
+		{[]string{"ь"}, 1, [6]string{"", "и", "и", "ь", "ью", "и"}},
+	}
+
+	// ruMaleNameForms — мужские имена с беглой гласной.
+	ruMaleNameForms = map[string][6]string{
+		"пётр":  {"", "Петра", "Петру", "Петра", "Петром", "Петре"},
+		"петр":  {"", "Петра", "Петру", "Петра", "Петром", "Петре"},
+		"павел": {"", "Павла", "Павлу", "Павла", "Павлом", "Павле"},
+		"лев":   {"", "Льва", "Льву", "Льва", "Львом", "Льве"},
+	}
+
+	// ruMaleNamesA — мужские имена на -а/-я.
+	ruMaleNamesA = map[string]bool{
+		"никита": true, "илья": true, "кузьма": true, "фома": true, "лука": true, "савва": true,
+		"данила": true, "гаврила": true, "добрыня": true, "мустафа": true, "муса": true, "иона": true,
+	}
+
+	// ruUnisexNames — уменьшительные имена, не позволяющие определить пол.
+	ruUnisexNames = map[string]bool{"саша": true, "женя": true, "валя": true, "шура": true}
+
+	// ruTurkicPatronymics — частицы тюркских отчеств: "Али оглы", "Гейдар кызы".
+	ruTurkicPatronymics = map[string]PersonGender{"оглы": PersonGenderMale, "улы": PersonGenderMale, "уулу": PersonGenderMale, "кызы": PersonGenderFemale}
+)
+
+// ParseFullName разбирает ФИО, записанное одной строкой в свободной форме.
+// Порядок слов определяется по отчеству (окончания -ич, -на, тюркские "оглы"/"кызы")
+// и фамильным суффиксам: поддерживаются "Фамилия Имя Отчество", "Имя Отчество Фамилия",
+// "Фамилия Имя", "Имя Фамилия" и "Имя Отчество". Регистр букв нормализуется,
+// пол определяется по отчеству, фамилии или имени.
+func ParseFullName(s string) (FullName, error) {
+	parts := strings.Fields(s)
+	for i, part := range parts {
+		parts[i] = capitalizeName(part)
+	}
+
+	// Тюркское отчество из двух слов объединяется
+	if n := len(parts); n >= 2 {
+		if _, ok := ruTurkicPatronymics[strings.ToLower(parts[n-1])]; ok {
+			parts = append(parts[:n-2], parts[n-2]+" "+strings.ToLower(parts[n-1]))
+		}
+	}
+
+	var fn FullName
+	switch len(parts) {
+	case 1:
+		if surnameScore(parts[0]) > 0 {
+			fn.Surname = parts[0]
+		} else {
+			fn.Name = parts[0]
+		}
+	case 2:
+		switch {
+		case isPatronymic(parts[1]):
+			fn.Name, fn.Patronymic = parts[0], parts[1]
+		case surnameScore(parts[1]) > surnameScore(parts[0]):
+			fn.Name, fn.Surname = parts[0], parts[1]
+		default:
+			fn.Surname, fn.Name = parts[0], parts[1]
+		}
+	case 3:
+		if isPatronymic(parts[1]) && !isPatronymic(parts[2]) {
+			fn.Name, fn.Patronymic, fn.Surname = parts[0], parts[1], parts[2]
+		} else {
+			fn.Surname, fn.Name, fn.Patronymic = parts[0], parts[1], parts[2]
+		}
+	default:
+		return FullName{}, ErrInvalidFullName
+	}
+
+	fn.Gender = fn.detectGender()
+	return fn, nil
+}
+
+// capitalizeName приводит к формату "Иванов" каждую часть слова, разделенную дефисом.
+func capitalizeName(s string) string {
+	parts := strings.Split(s, "-")
+	for i, part := range parts {
+		parts[i] = Capitalize(part)
+	}
+	return strings.Join(parts, "-")
+}
+
+// isPatronymic сообщает, похоже ли слово на отчество.
+func isPatronymic(s string) bool {
+	return patronymicGender(s) != PersonGenderUnknown
+}
+
+// patronymicGender определяет пол по отчеству.
+func patronymicGender(s string) PersonGender {
+	lower := strings.ToLower(s)
+	if i := strings.LastIndexByte(lower, ' '); i >= 0 {
+		return ruTurkicPatronymics[lower[i+1:]]
+	}
+	switch {
+	case StringLength(lower) > 4 && hasAnySuffix(lower, "ович", "евич", "ич"):
+		return PersonGenderMale
+	case StringLength(lower) > 4 && hasAnySuffix(lower, "овна", "евна", "ична", "инична"):
+		return PersonGenderFemale
+	}
+	return PersonGenderUnknown
+}
+
+// ruSurnameGender определяет пол по типичному суффиксу русской фамилии.
+func ruSurnameGender(s string) PersonGender {
+	lower := strings.ToLower(s)
+	if StringLength(lower) < 4 {
+		return PersonGenderUnknown
+	}
+	switch {
+	case hasAnySuffix(lower, "ова", "ева", "ёва", "ина", "ына", "ская", "цкая"):
+		return PersonGenderFemale
+	case hasAnySuffix(lower, "ов", "ев", "ёв", "ин", "ын", "ский", "цкий"):
+		return PersonGenderMale
+	}
+	return PersonGenderUnknown
+}
+
+// surnameScore оценивает, насколько слово похоже на фамилию: 2 — суффиксы -ов, -ев, -ский
+// и их женские формы, 1 — суффиксы -ин, -ын, которые встречаются и в именах (Марина), 0 — прочие.
+func surnameScore(s string) int {
+	if ruSurnameGender(s) == PersonGenderUnknown {
+		return 0
+	}
+	if hasAnySuffix(strings.ToLower(s), "ин", "ын", "ина", "ына") {
+		return 1
+	}
+	return 2
+}
+
+// nameGender определяет пол по окончанию имени.
+func nameGender(s string) PersonGender {
+	lower := strings.ToLower(s)
+	switch {
+	case lower == "" || ruUnisexNames[lower]:
+		return PersonGenderUnknown
+	case ruMaleNamesA[lower]:
+		return PersonGenderMale
+	case hasAnySuffix(lower, "а", "я"), lower == "любовь":
+		return PersonGenderFemale
+	case hasAnySuffix(lower, "ь", "й") || isRuConsonant(lastRune(lower)):
+		return PersonGenderMale
+	}
+	return PersonGenderUnknown
+}
+
+// detectGender определяет пол по отчеству, затем по фамилии и имени.
+func (n FullName) detectGender() PersonGender {
+	if g := patronymicGender(n.Patronymic); g != PersonGenderUnknown {
+		return g
+	}
+	if g := ruSurnameGender(n.Surname); g != PersonGenderUnknown {
+		return g
+	}
+	return nameGender(n.Name)
+}
+
+// String возвращает ФИО в формате "Фамилия Имя Отчество".
+func (n FullName) String() string {
+	return joinNonEmpty(n.Surname, n.Name, n.Patronymic)
+}
+
+// Initials возвращает ФИО в формате "Фамилия И.О." (как CreateInitials).
+func (n FullName) Initials() string {
+	return joinNonEmpty(n.Surname, n.initials())
+}
+
+// InitialsFirst возвращает ФИО в формате "И.О. Фамилия", принятом в подписях документов.
+func (n FullName) InitialsFirst() string {
+	return joinNonEmpty(n.initials(), n.Surname)
+}
+
+// NameWithPatronymic возвращает имя и отчество: "Иван Иванович".
+func (n FullName) NameWithPatronymic() string {
+	return joinNonEmpty(n.Name, n.Patronymic)
+}
+
+// initials возвращает инициалы имени и отчества: "И.О.".
+func (n FullName) initials() string {
+	var b strings.Builder
+	for _, part := range []string{n.Name, n.Patronymic} {
+		if part != "" {
+			b.WriteString(strings.ToUpper(CutString(part, 1)))
+			b.WriteString(".")
+		}
+	}
+	return b.String()
+}
+
+// Decline возвращает ФИО в падеже c: Decline(CaseDative) для "Иванов Иван Иванович"
+// дает "Иванову Ивану Ивановичу". Пол берется из поля Gender; если он неизвестен,
+// фамилия и имя склоняются по мужским правилам. Несклоняемые фамилии
+// (Черных, Шевченко, женские на согласный) остаются без изменений.
+func (n FullName) Decline(c GrammaticalCase) FullName {
+	if c == CaseNominative {
+		return n
+	}
+
+	surnameRules, nameRules := ruMaleSurnameRules, ruMaleNameRules
+	if n.Gender == PersonGenderFemale {
+		surnameRules, nameRules = ruFemaleSurnameRules, ruFemaleNameRules
+	}
+
+	declined := n
+	parts := strings.Split(n.Surname, "-")
+	for i, part := range parts {
+		parts[i] = declineNamePart(part, surnameRules, c)
+	}
+	declined.Surname = strings.Join(parts, "-")
+
+	if forms, ok := ruMaleNameForms[strings.ToLower(n.Name)]; ok && n.Gender != PersonGenderFemale {
+		declined.Name = forms[c]
+	} else {
+		declined.Name = declineNamePart(n.Name, nameRules, c)
+	}
+
+	if !strings.Contains(n.Patronymic, " ") {
+		declined.Patronymic = declineNamePart(n.Patronymic, ruPatronymicRules, c)
+	}
+	return declined
+}
+
+// DeclineFullName разбирает ФИО (см. ParseFullName) и возвращает его в падеже c
+// с сохранением исходного порядка "Фамилия Имя Отчество".
+func DeclineFullName(s string, c GrammaticalCase) (string, error) {
+	fn, err := ParseFullName(s)
+	if err != nil {
+		return "", err
+	}
+	return fn.Decline(c).String(), nil
+}
+
+// declineNamePart склоняет одно слово по первому подходящему правилу.
+// Слова, для которых правило не найдено, не изменяются.
+func declineNamePart(word string, rules []nameRule, c GrammaticalCase) string {
+	if word == "" || c == CaseNominative {
+		return word
+	}
+	lower := strings.ToLower(word)
+	for _, rule := range rules {
+		if !hasAnySuffix(lower, rule.suffixes...) {
+			continue
+		}
+		if rule.endings == ruIndeclinable {
+			return word
+		}
+		runes := []rune(word)
+		return string(runes[:len(runes)-rule.cut]) + rule.endings[c]
+	}
+	return word
+}
+
+// isRuConsonant сообщает, является ли r согласной буквой русского алфавита.
+func isRuConsonant(r rune) bool {
+	return strings.ContainsRune("бвгджзклмнпрстфхцчшщ", unicode.ToLower(r))
+}
+
+// lastRune возвращает последнюю руну строки.
+func lastRune(s string) rune {
+	runes := []rune(s)
+	if len(runes) == 0 {
+		return 0
+	}
+	return runes[len(runes)-1]
+}
+
+// hasAnySuffix сообщает, оканчивается ли s на один из суффиксов.
+func hasAnySuffix(s string, suffixes ...string) bool {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(s, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// joinNonEmpty соединяет непустые строки пробелом.
+func joinNonEmpty(parts ...string) string {
+	var nonEmpty []string
+	for _, part := range parts {
+		if part != "" {
+			nonEmpty = append(nonEmpty, part)
+		}
+	}
+	return strings.Join(nonEmpty, " ")
+}
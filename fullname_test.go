@@ -0,0 +1,113 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseFullName(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want FullName
+	}{
+		{"Фамилия Имя Отчество", "иванов иван иванович", FullName{"Иванов", "Иван", "Иванович", PersonGenderMale}},
+		{"Имя Отчество Фамилия", "Анна  Сергеевна КАРЕНИНА", FullName{"Каренина", "Анна", "Сергеевна", PersonGenderFemale}},
+		{"Имя Фамилия", "Пётр Чайковский", FullName{"Чайковский", "Пётр", "", PersonGenderMale}},
+		{"Фамилия Имя", "Петрова Марина", FullName{"Петрова", "Марина", "", PersonGenderFemale}},
+		{"Имя Фамилия с похожим на фамилию именем", "Марина Петрова", FullName{"Петрова", "Марина", "", PersonGenderFemale}},
+		{"Имя Отчество", "Илья Ильич", FullName{"", "Илья", "Ильич", PersonGenderMale}},
+		{"Двойная фамилия", "римский-корсаков николай андреевич", FullName{"Римский-Корсаков", "Николай", "Андреевич", PersonGenderMale}},
+		{"Тюркское отчество", "Алиев Рашид Гейдар оглы", FullName{"Алиев", "Рашид", "Гейдар оглы", PersonGenderMale}},
+		{"Только имя", "Никита", FullName{"", "Никита", "", PersonGenderMale}},
+		{"Только фамилия", "Смирнова", FullName{"Смирнова", "", "", PersonGenderFemale}},
+		{"Пол неизвестен", "Черных Саша", FullName{"Черных", "Саша", "", PersonGenderUnknown}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseFullName(tt.s)
+			if err != nil {
+				t.Fatalf("ParseFullName() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseFullName() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	for _, s := range []string{"", "   ", "a b c d"} {
+		if _, err := ParseFullName(s); !errors.Is(err, ErrInvalidFullName) {
+			t.Errorf("ParseFullName(%q) error = %v, want ErrInvalidFullName", s, err)
+		}
+	}
+}
+
+func TestFullNameFormats(t *testing.T) {
+	fn := FullName{Surname: "Иванов", Name: "Иван", Patronymic: "Иванович"}
+	if got := fn.String(); got != "Иванов Иван Иванович" {
+		t.Errorf("String() = %q", got)
+	}
+	if got := fn.Initials(); got != "Иванов И.И." {
+		t.Errorf("Initials() = %q", got)
+	}
+	if got := fn.InitialsFirst(); got != "И.И. Иванов" {
+		t.Errorf("InitialsFirst() = %q", got)
+	}
+	if got := fn.NameWithPatronymic(); got != "Иван Иванович" {
+		t.Errorf("NameWithPatronymic() = %q", got)
+	}
+
+	short := FullName{Surname: "Петров", Name: "Сергей"}
+	if got := short.Initials(); got != "Петров С." {
+		t.Errorf("Initials() = %q", got)
+	}
+	if got := (FullName{Surname: "Петров"}).InitialsFirst(); got != "Петров" {
+		t.Errorf("InitialsFirst() = %q", got)
+	}
+}
+
+func TestDeclineFullName(t *testing.T) {
+	tests := []struct {
+		s    string
+		c    GrammaticalCase
+		want string
+	}{
+		{"Иванов Иван Иванович", CaseNominative, "Иванов Иван Иванович"},
+		{"Иванов Иван Иванович", CaseGenitive, "Иванова Ивана Ивановича"},
+		{"Иванов Иван Иванович", CaseDative, "Иванову Ивану Ивановичу"},
+		{"Иванов Иван Иванович", CaseAccusative, "Иванова Ивана Ивановича"},
+		{"Иванов Иван Иванович", CaseInstrumental, "Ивановым Иваном Ивановичем"},
+		{"Иванов Иван Иванович", CasePrepositional, "Иванове Иване Ивановиче"},
+		{"Иванова Анна Сергеевна", CaseGenitive, "Ивановой Анны Сергеевны"},
+		{"Иванова Анна Сергеевна", CaseDative, "Ивановой Анне Сергеевне"},
+		{"Иванова Анна Сергеевна", CaseAccusative, "Иванову Анну Сергеевну"},
+		{"Иванова Анна Сергеевна", CaseInstrumental, "Ивановой Анной Сергеевной"},
+		{"Достоевский Фёдор Михайлович", CaseInstrumental, "Достоевским Фёдором Михайловичем"},
+		{"Толстой Лев Николаевич", CaseGenitive, "Толстого Льва Николаевича"},
+		{"Толстая Софья Андреевна", CaseAccusative, "Толстую Софью Андреевну"},
+		{"Римский-Корсаков Николай Андреевич", CaseDative, "Римскому-Корсакову Николаю Андреевичу"},
+		{"Шевченко Тарас Григорьевич", CaseGenitive, "Шевченко Тараса Григорьевича"},
+		{"Черных Ольга Петровна", CaseGenitive, "Черных Ольги Петровны"},
+		{"Шмидт Мария Ивановна", CasePrepositional, "Шмидт Марии Ивановне"},
+		{"Шмидт Аркадий Петрович", CasePrepositional, "Шмидте Аркадии Петровиче"},
+		{"Глинка Михаил Иванович", CaseInstrumental, "Глинкой Михаилом Ивановичем"},
+		{"Гоголь Николай Васильевич", CaseDative, "Гоголю Николаю Васильевичу"},
+		{"Кузнецов Илья Ильич", CaseInstrumental, "Кузнецовым Ильёй Ильичом"},
+		{"Смирнова Любовь Павловна", CaseInstrumental, "Смирновой Любовью Павловной"},
+		{"Алиев Рашид Гейдар оглы", CaseDative, "Алиеву Рашиду Гейдар оглы"},
+	}
+	for _, tt := range tests {
+		got, err := DeclineFullName(tt.s, tt.c)
+		if err != nil {
+			t.Fatalf("DeclineFullName(%q) error = %v", tt.s, err)
+		}
+		if got != tt.want {
+			t.Errorf("DeclineFullName(%q, %d) = %q, want %q", tt.s, tt.c, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,251 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// Levenshtein возвращает расстояние Левенштейна между строками — минимальное число вставок,
+// удалений и замен символов (рун), превращающих одну строку в другую.
+// Сравнение учитывает регистр: Levenshtein("кот", "Кот") = 1.
+func Levenshtein(a, b string) int {
+	d, _ := levenshtein([]rune(a), []rune(b), -1, false)
+	return d
+}
+
+// LevenshteinMax вычисляет расстояние Левенштейна, прекращая вычисление, как только
+// оно гарантированно превысит maxDistance. Если расстояние больше maxDistance,
+// возвращается maxDistance+1 и false. Используется для быстрого отсева кандидатов.
+func LevenshteinMax(a, b string, maxDistance int) (int, bool) {
+	return levenshtein([]rune(a), []rune(b), maxDistance, false)
+}
+
+// DamerauLevenshtein возвращает расстояние Дамерау–Левенштейна (вариант оптимального
+// выравнивания строк): помимо вставок, удалений и замен перестановка двух соседних
+// символов считается одной операцией. DamerauLevenshtein("привет", "пирвет") = 1.
+func DamerauLevenshtein(a, b string) int {
+	d, _ := levenshtein([]rune(a), []rune(b), -1, true)
+	return d
+}
+
+// DamerauLevenshteinMax — вариант DamerauLevenshtein с ранним выходом (см. LevenshteinMax).
+func DamerauLevenshteinMax(a, b string, maxDistance int) (int, bool) {
+	return levenshtein([]rune(a), []rune(b), maxDistance, true)
+}
+
+// levenshtein вычисляет расстояние редактирования; maxDistance < 0 означает отсутствие ограничения,
+// transpositions включает перестановки соседних символов.
+func levenshtein(a, b []rune, maxDistance int, transpositions bool) (int, bool) {
+	// Общие начало и конец не влияют на расстояние
+	for len(a) > 0 && len(b) > 0 && a[0] == b[0] {
+		a, b = a[1:], b[1:]
+	}
+	for len(a) > 0 && len(b) > 0 && a[len(a)-1] == b[len(b)-1] {
+		a, b = a[:len(a)-1], b[:len(b)-1]
+	}
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+
+	exceeded := func(d int) bool { return maxDistance >= 0 && d > maxDistance }
+	if exceeded(len(a) - len(b)) {
+		return maxDistance + 1, false
+	}
+	if len(b) == 0 {
+		return len(a), true
+	}
+
+	// Три строки матрицы: предыдущая (для перестановок), текущая и новая
+	prevPrev := make([]int, len(b)+1)
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		rowMin := i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			d := min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			if transpositions && i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				d = min(d, prevPrev[j-2]+1)
+			}
+			curr[j] = d
+			rowMin = min(rowMin, d)
+		}
+		// Значения в следующих строках не меньше минимума текущей
+		// (для перестановок — минимума двух последних строк)
+		if exceeded(rowMin) && (!transpositions || exceeded(slices.Min(prev))) {
+			return maxDistance + 1, false
+		}
+		prevPrev, prev, curr = prev, curr, prevPrev
+	}
+
+	d := prev[len(b)]
+	if exceeded(d) {
+		return maxDistance + 1, false
+	}
+	return d, true
+}
+
+// Jaro возвращает сходство Джаро в диапазоне [0, 1]: 1 — строки совпадают, 0 — общих символов нет.
+func Jaro(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) == 0 && len(rb) == 0 {
+		return 1
+	}
+	if len(ra) == 0 || len(rb) == 0 {
+		return 0
+	}
+
+	window := max(len(ra), len(rb))/2 - 1
+	window = max(window, 0)
+	matchedA := make([]bool, len(ra))
+	matchedB := make([]bool, len(rb))
+	matches := 0
+	for i, r := range ra {
+		for j := max(0, i-window); j < min(len(rb), i+window+1); j++ {
+			if !matchedB[j] && rb[j] == r {
+				matchedA[i], matchedB[j] = true, true
+				matches++
+				break
+			}
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+
+	// Число совпавших символов, стоящих в разном порядке
+	transpositions, j := 0, 0
+	for i, r := range ra {
+		if !matchedA[i] {
+			continue
+		}
+		for !matchedB[j] {
+			j++
+		}
+		if r != rb[j] {
+			transpositions++
+		}
+		j++
+	}
+
+	m := float64(matches)
+	return (m/float64(len(ra)) + m/float64(len(rb)) + (m-float64(transpositions)/2)/m) / 3
+}
+
+// JaroWinkler возвращает сходство Джаро–Винклера в диапазоне [0, 1]: сходство Джаро,
+// увеличенное для строк с общим началом (до 4 символов). Хорошо подходит для коротких
+// строк — имен, названий, опечаток в словах.
+func JaroWinkler(a, b string) float64 {
+	const (
+		boostThreshold = 0.7
+		prefixScale    = 0.1
+		maxPrefix      = 4
+	)
+	sim := Jaro(a, b)
+	if sim <= boostThreshold {
+		return sim
+	}
+
+	prefix := 0
+	ra, rb := []rune(a), []rune(b)
+	for prefix < min(len(ra), len(rb), maxPrefix) && ra[prefix] == rb[prefix] {
+		prefix++
+	}
+	return sim + float64(prefix)*prefixScale*(1-sim)
+}
+
+// trigrams возвращает множество триграмм строки так же, как расширение PostgreSQL pg_trgm:
+// строка приводится к нижнему регистру и разбивается на слова из букв и цифр,
+// каждое слово дополняется двумя пробелами в начале и одним в конце.
+func trigrams(s string) map[string]struct{} {
+	set := make(map[string]struct{})
+	words := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, word := range words {
+		runes := []rune("  " + word + " ")
+		for i := 0; i+3 <= len(runes); i++ {
+			set[string(runes[i:i+3])] = struct{}{}
+		}
+	}
+	return set
+}
+
+// TrigramSimilarity возвращает сходство строк по триграммам (как similarity в pg_trgm):
+// отношение числа общих триграмм к числу всех триграмм обеих строк, от 0 до 1.
+// Регистр и знаки препинания не учитываются, порядок слов влияет слабо, поэтому
+// метод подходит для названий компаний и длинных строк.
+func TrigramSimilarity(a, b string) float64 {
+	ta, tb := trigrams(a), trigrams(b)
+	if len(ta) == 0 || len(tb) == 0 {
+		if len(ta) == len(tb) && strings.EqualFold(a, b) {
+			return 1
+		}
+		return 0
+	}
+
+	common := 0
+	for t := range ta {
+		if _, ok := tb[t]; ok {
+			common++
+		}
+	}
+	return float64(common) / float64(len(ta)+len(tb)-common)
+}
+
+// FuzzyMatch — кандидат, найденный FuzzyFind.
+type FuzzyMatch struct {
+	Value string  // кандидат
+	Index int     // индекс кандидата в исходном списке
+	Score float64 // сходство с запросом
+}
+
+// FuzzyOptions — параметры поиска FuzzyFind.
+type FuzzyOptions struct {
+	// Limit — максимальное число результатов; 0 — без ограничения
+	Limit int
+	// MinScore — минимальное сходство, при котором кандидат попадает в результат
+	MinScore float64
+	// Similarity — функция сходства; по умолчанию JaroWinkler
+	Similarity func(a, b string) float64
+}
+
+// FuzzyFind сравнивает запрос с каждым кандидатом без учета регистра и возвращает наиболее
+// похожих в порядке убывания сходства (при равенстве — в исходном порядке).
+func FuzzyFind(query string, candidates []string, opts FuzzyOptions) []FuzzyMatch {
+	similarity := opts.Similarity
+	if similarity == nil {
+		similarity = JaroWinkler
+	}
+
+	query = strings.ToLower(strings.TrimSpace(query))
+	var matches []FuzzyMatch
+	for i, candidate := range candidates {
+		score := similarity(query, strings.ToLower(strings.TrimSpace(candidate)))
+		if score >= opts.MinScore {
+			matches = append(matches, FuzzyMatch{Value: candidate, Index: i, Score: score})
+		}
+	}
+
+	slices.SortStableFunc(matches, func(x, y FuzzyMatch) int {
+		return cmp.Compare(y.Score, x.Score)
+	})
+	if opts.Limit > 0 && len(matches) > opts.Limit {
+		matches = matches[:opts.Limit]
+	}
+	return matches
+}
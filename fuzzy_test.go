@@ -0,0 +1,164 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+		damerau  int
+	}{
+		{"", "", 0, 0},
+		{"", "кот", 3, 3},
+		{"kitten", "sitting", 3, 3},
+		{"кот", "кит", 1, 1},
+		{"кот", "Кот", 1, 1},
+		{"привет", "пирвет", 2, 1},
+		{"ca", "abc", 3, 3},
+		{"Москва", "Масква", 1, 1},
+		{"ёжик", "ежик", 1, 1},
+		{"👍🏻", "👍🏿", 1, 1},
+		{"abcdef", "badcfe", 4, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"_"+tt.b, func(t *testing.T) {
+			if got := Levenshtein(tt.a, tt.b); got != tt.expected {
+				t.Errorf("Levenshtein(%q, %q) = %d; ожидается %d", tt.a, tt.b, got, tt.expected)
+			}
+			if got := Levenshtein(tt.b, tt.a); got != tt.expected {
+				t.Errorf("Levenshtein(%q, %q) = %d; ожидается %d", tt.b, tt.a, got, tt.expected)
+			}
+			if got := DamerauLevenshtein(tt.a, tt.b); got != tt.damerau {
+				t.Errorf("DamerauLevenshtein(%q, %q) = %d; ожидается %d", tt.a, tt.b, got, tt.damerau)
+			}
+		})
+	}
+}
+
+func TestLevenshteinMax(t *testing.T) {
+	tests := []struct {
+		name        string
+		a, b        string
+		maxDistance int
+		expected    int
+		ok          bool
+	}{
+		{"В пределах", "kitten", "sitting", 3, 3, true},
+		{"Превышение", "kitten", "sitting", 2, 3, false},
+		{"Разница длин", "а", "абвгдеж", 2, 3, false},
+		{"Равные строки", "тест", "тест", 0, 0, true},
+		{"Нулевой предел", "тест", "текст", 0, 1, false},
+		{"Длинные разные строки", "aaaaaaaaaaaaaaaaaaaa", "bbbbbbbbbbbbbbbbbbbb", 5, 6, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := LevenshteinMax(tt.a, tt.b, tt.maxDistance)
+			if got != tt.expected || ok != tt.ok {
+				t.Errorf("LevenshteinMax(%q, %q, %d) = %d, %v; ожидается %d, %v", tt.a, tt.b, tt.maxDistance, got, ok, tt.expected, tt.ok)
+			}
+		})
+	}
+
+	if got, ok := DamerauLevenshteinMax("привет", "пирвет", 1); got != 1 || !ok {
+		t.Errorf("DamerauLevenshteinMax() = %d, %v; ожидается 1, true", got, ok)
+	}
+	if got, ok := DamerauLevenshteinMax("abcdef", "badcfe", 2); got != 3 || ok {
+		t.Errorf("DamerauLevenshteinMax() = %d, %v; ожидается 3, false", got, ok)
+	}
+}
+
+func TestJaroWinkler(t *testing.T) {
+	tests := []struct {
+		a, b    string
+		jaro    float64
+		winkler float64
+	}{
+		{"", "", 1, 1},
+		{"", "abc", 0, 0},
+		{"abc", "xyz", 0, 0},
+		{"MARTHA", "MARHTA", 0.9444, 0.9611},
+		{"DIXON", "DICKSONX", 0.7667, 0.8133},
+		{"Марта", "Марат", 0.9333, 0.9533},
+		{"одинаково", "одинаково", 1, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"_"+tt.b, func(t *testing.T) {
+			if got := Jaro(tt.a, tt.b); math.Abs(got-tt.jaro) > 1e-4 {
+				t.Errorf("Jaro(%q, %q) = %.4f; ожидается %.4f", tt.a, tt.b, got, tt.jaro)
+			}
+			if got := JaroWinkler(tt.a, tt.b); math.Abs(got-tt.winkler) > 1e-4 {
+				t.Errorf("JaroWinkler(%q, %q) = %.4f; ожидается %.4f", tt.a, tt.b, got, tt.winkler)
+			}
+		})
+	}
+}
+
+func TestTrigramSimilarity(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected float64
+	}{
+		{"", "", 1},
+		{"", "кот", 0},
+		{"word", "word", 1},
+		{"word", "WORD!", 1},
+		{"word", "two words", 0.3636},
+		{"ООО Ромашка", "ромашка ооо", 1},
+		{"кот", "собака", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"_"+tt.b, func(t *testing.T) {
+			if got := TrigramSimilarity(tt.a, tt.b); math.Abs(got-tt.expected) > 1e-4 {
+				t.Errorf("TrigramSimilarity(%q, %q) = %.4f; ожидается %.4f", tt.a, tt.b, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFuzzyFind(t *testing.T) {
+	candidates := []string{"Москва", "Мурманск", "Минск", "Магадан", "москва-сити"}
+
+	t.Run("По умолчанию", func(t *testing.T) {
+		got := FuzzyFind("масква", candidates, FuzzyOptions{Limit: 2})
+		values := []string{got[0].Value, got[1].Value}
+		if !reflect.DeepEqual(values, []string{"Москва", "Минск"}) || got[0].Index != 0 {
+			t.Errorf("FuzzyFind() = %v; ожидается Москва, Минск", got)
+		}
+	})
+
+	t.Run("Минимальное сходство", func(t *testing.T) {
+		got := FuzzyFind("ромашка", []string{"ООО Ромашка", "Лютик", "ромашки"}, FuzzyOptions{
+			MinScore:   0.5,
+			Similarity: TrigramSimilarity,
+		})
+		if len(got) != 2 || got[0].Value != "ООО Ромашка" || got[1].Value != "ромашки" {
+			t.Errorf("FuzzyFind() = %v; ожидается ООО Ромашка, ромашки", got)
+		}
+	})
+
+	t.Run("Равное сходство сохраняет порядок", func(t *testing.T) {
+		got := FuzzyFind("x", []string{"b", "a", "c"}, FuzzyOptions{})
+		if len(got) != 3 || got[0].Index != 0 || got[1].Index != 1 || got[2].Index != 2 {
+			t.Errorf("FuzzyFind() = %v; ожидается исходный порядок", got)
+		}
+	})
+
+	t.Run("Пустой список", func(t *testing.T) {
+		if got := FuzzyFind("x", nil, FuzzyOptions{}); len(got) != 0 {
+			t.Errorf("FuzzyFind() = %v; ожидается пустой результат", got)
+		}
+	})
+}
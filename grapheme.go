@@ -0,0 +1,284 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"iter"
+	"sort"
+	"unicode"
+	"unicode/utf8"
+)
+
+// graphemeProp — значение свойства Grapheme_Cluster_Break (UAX #29).
+type graphemeProp uint8
+
+const (
+	gpOther graphemeProp = iota
+	gpCR
+	gpLF
+	gpControl
+	gpExtend
+	gpZWJ
+	gpRegionalIndicator
+	gpPrepend
+	gpSpacingMark
+	gpL
+	gpV
+	gpT
+	gpLV
+	gpLVT
+	gpExtendedPictographic
+)
+
+// graphemeRange — диапазон кодовых точек с одинаковым свойством.
+type graphemeRange struct {
+	lo, hi rune
+	prop   graphemeProp
+}
+
+// graphemeRanges — кодовые точки, свойство которых не выводится из общей категории Unicode.
+// Таблица упорядочена по возрастанию и составлена по GraphemeBreakProperty.txt и emoji-data.txt;
+// Extended_Pictographic приведен без неназначенных кодовых точек внутри блоков эмодзи.
+var graphemeRanges = []graphemeRange{
+	{0x00A9, 0x00A9, gpExtendedPictographic},
+	{0x00AE, 0x00AE, gpExtendedPictographic},
+	{0x0600, 0x0605, gpPrepend},
+	{0x06DD, 0x06DD, gpPrepend},
+	{0x070F, 0x070F, gpPrepend},
+	{0x0890, 0x0891, gpPrepend},
+	{0x08E2, 0x08E2, gpPrepend},
+	{0x1100, 0x115F, gpL},
+	{0x1160, 0x11A7, gpV},
+	{0x11A8, 0x11FF, gpT},
+	{0x200C, 0x200C, gpExtend},
+	{0x200D, 0x200D, gpZWJ},
+	{0x203C, 0x203C, gpExtendedPictographic},
+	{0x2049, 0x2049, gpExtendedPictographic},
+	{0x2122, 0x2122, gpExtendedPictographic},
+	{0x2139, 0x2139, gpExtendedPictographic},
+	{0x2194, 0x2199, gpExtendedPictographic},
+	{0x21A9, 0x21AA, gpExtendedPictographic},
+	{0x231A, 0x231B, gpExtendedPictographic},
+	{0x2328, 0x2328, gpExtendedPictographic},
+	{0x2388, 0x2388, gpExtendedPictographic},
+	{0x23CF, 0x23CF, gpExtendedPictographic},
+	{0x23E9, 0x23F3, gpExtendedPictographic},
+	{0x23F8, 0x23FA, gpExtendedPictographic},
+	{0x24C2, 0x24C2, gpExtendedPictographic},
+	{0x25AA, 0x25AB, gpExtendedPictographic},
+	{0x25B6, 0x25B6, gpExtendedPictographic},
+	{0x25C0, 0x25C0, gpExtendedPictographic},
+	{0x25FB, 0x25FE, gpExtendedPictographic},
+	{0x2600, 0x2605, gpExtendedPictographic},
+	{0x2607, 0x2612, gpExtendedPictographic},
+	{0x2614, 0x2685, gpExtendedPictographic},
+	{0x2690, 0x2705, gpExtendedPictographic},
+	{0x2708, 0x2712, gpExtendedPictographic},
+	{0x2714, 0x2714, gpExtendedPictographic},
+	{0x2716, 0x2716, gpExtendedPictographic},
+	{0x271D, 0x271D, gpExtendedPictographic},
+	{0x2721, 0x2721, gpExtendedPictographic},
+	{0x2728, 0x2728, gpExtendedPictographic},
+	{0x2733, 0x2734, gpExtendedPictographic},
+	{0x2744, 0x2744, gpExtendedPictographic},
+	{0x2747, 0x2747, gpExtendedPictographic},
+	{0x274C, 0x274C, gpExtendedPictographic},
+	{0x274E, 0x274E, gpExtendedPictographic},
+	{0x2753, 0x2755, gpExtendedPictographic},
+	{0x2757, 0x2757, gpExtendedPictographic},
+	{0x2763, 0x2767, gpExtendedPictographic},
+	{0x2795, 0x2797, gpExtendedPictographic},
+	{0x27A1, 0x27A1, gpExtendedPictographic},
+	{0x27B0, 0x27B0, gpExtendedPictographic},
+	{0x27BF, 0x27BF, gpExtendedPictographic},
+	{0x2934, 0x2935, gpExtendedPictographic},
+	{0x2B05, 0x2B07, gpExtendedPictographic},
+	{0x2B1B, 0x2B1C, gpExtendedPictographic},
+	{0x2B50, 0x2B50, gpExtendedPictographic},
+	{0x2B55, 0x2B55, gpExtendedPictographic},
+	{0x3030, 0x3030, gpExtendedPictographic},
+	{0x303D, 0x303D, gpExtendedPictographic},
+	{0x3297, 0x3297, gpExtendedPictographic},
+	{0x3299, 0x3299, gpExtendedPictographic},
+	{0xA960, 0xA97C, gpL},
+	{0xD7B0, 0xD7C6, gpV},
+	{0xD7CB, 0xD7FB, gpT},
+	{0xFF9E, 0xFF9F, gpExtend},
+	{0x110BD, 0x110BD, gpPrepend},
+	{0x110CD, 0x110CD, gpPrepend},
+	{0x1F000, 0x1F0FF, gpExtendedPictographic},
+	{0x1F10D, 0x1F10F, gpExtendedPictographic},
+	{0x1F12F, 0x1F12F, gpExtendedPictographic},
+	{0x1F16C, 0x1F171, gpExtendedPictographic},
+	{0x1F17E, 0x1F17F, gpExtendedPictographic},
+	{0x1F18E, 0x1F18E, gpExtendedPictographic},
+	{0x1F191, 0x1F19A, gpExtendedPictographic},
+	{0x1F1AD, 0x1F1E5, gpExtendedPictographic},
+	{0x1F1E6, 0x1F1FF, gpRegionalIndicator},
+	{0x1F201, 0x1F20F, gpExtendedPictographic},
+	{0x1F21A, 0x1F21A, gpExtendedPictographic},
+	{0x1F22F, 0x1F22F, gpExtendedPictographic},
+	{0x1F232, 0x1F23A, gpExtendedPictographic},
+	{0x1F23C, 0x1F23F, gpExtendedPictographic},
+	{0x1F249, 0x1F3FA, gpExtendedPictographic},
+	{0x1F3FB, 0x1F3FF, gpExtend},
+	{0x1F400, 0x1F53D, gpExtendedPictographic},
+	{0x1F546, 0x1F64F, gpExtendedPictographic},
+	{0x1F680, 0x1F6FF, gpExtendedPictographic},
+	{0x1F774, 0x1F77F, gpExtendedPictographic},
+	{0x1F7D5, 0x1F7FF, gpExtendedPictographic},
+	{0x1F80C, 0x1F80F, gpExtendedPictographic},
+	{0x1F848, 0x1F84F, gpExtendedPictographic},
+	{0x1F85A, 0x1F85F, gpExtendedPictographic},
+	{0x1F888, 0x1F88F, gpExtendedPictographic},
+	{0x1F8AE, 0x1F8FF, gpExtendedPictographic},
+	{0x1F90C, 0x1F93A, gpExtendedPictographic},
+	{0x1F93C, 0x1F945, gpExtendedPictographic},
+	{0x1F947, 0x1FAFF, gpExtendedPictographic},
+	{0x1FC00, 0x1FFFD, gpExtendedPictographic},
+	{0xE0020, 0xE007F, gpExtend},
+}
+
+// graphemePropOf возвращает свойство Grapheme_Cluster_Break кодовой точки.
+func graphemePropOf(r rune) graphemeProp {
+	switch {
+	case r == '\r':
+		return gpCR
+	case r == '\n':
+		return gpLF
+	case r < 0x7F && r >= 0x20:
+		return gpOther
+	case r >= 0xAC00 && r <= 0xD7A3:
+		// Слоги хангыль: каждый 28-й — LV, остальные — LVT
+		if (r-0xAC00)%28 == 0 {
+			return gpLV
+		}
+		return gpLVT
+	}
+
+	i := sort.Search(len(graphemeRanges), func(i int) bool { return graphemeRanges[i].hi >= r })
+	if i < len(graphemeRanges) && graphemeRanges[i].lo <= r {
+		return graphemeRanges[i].prop
+	}
+
+	switch {
+	case unicode.In(r, unicode.Mn, unicode.Me):
+		return gpExtend
+	case unicode.Is(unicode.Mc, r):
+		return gpSpacingMark
+	case unicode.In(r, unicode.Cc, unicode.Cf, unicode.Zl, unicode.Zp):
+		return gpControl
+	}
+	return gpOther
+}
+
+// graphemeClusterLen возвращает длину в байтах первого расширенного графемного кластера строки.
+// Некорректные байты UTF-8 образуют отдельные кластеры длиной в один байт.
+func graphemeClusterLen(s string) int {
+	if s == "" {
+		return 0
+	}
+	r, pos := utf8.DecodeRuneInString(s)
+	prev := graphemePropOf(r)
+	if r == utf8.RuneError && pos == 1 {
+		return 1
+	}
+
+	pictographic := prev == gpExtendedPictographic // идет последовательность ExtPict Extend*
+	joiner := false                                // предыдущий ZWJ завершает ExtPict Extend*
+	regional := 0                                  // число подряд идущих региональных индикаторов
+	if prev == gpRegionalIndicator {
+		regional = 1
+	}
+
+	for pos < len(s) {
+		r, size := utf8.DecodeRuneInString(s[pos:])
+		if r == utf8.RuneError && size == 1 {
+			break
+		}
+		next := graphemePropOf(r)
+		if graphemeBreak(prev, next, joiner, regional) {
+			break
+		}
+
+		joiner = next == gpZWJ && pictographic
+		pictographic = next == gpExtendedPictographic || next == gpExtend && pictographic
+		if next == gpRegionalIndicator {
+			regional++
+		} else {
+			regional = 0
+		}
+
+		prev = next
+		pos += size
+	}
+	return pos
+}
+
+// graphemeBreak сообщает, есть ли граница кластера между свойствами prev и next (правила GB3-GB999).
+// joiner — prev является ZWJ после ExtPict Extend*, regional — число региональных индикаторов подряд.
+func graphemeBreak(prev, next graphemeProp, joiner bool, regional int) bool {
+	switch {
+	case prev == gpCR && next == gpLF: // GB3
+		return false
+	case prev == gpCR, prev == gpLF, prev == gpControl: // GB4
+		return true
+	case next == gpCR, next == gpLF, next == gpControl: // GB5
+		return true
+	case prev == gpL && (next == gpL || next == gpV || next == gpLV || next == gpLVT): // GB6
+		return false
+	case (prev == gpLV || prev == gpV) && (next == gpV || next == gpT): // GB7
+		return false
+	case (prev == gpLVT || prev == gpT) && next == gpT: // GB8
+		return false
+	case next == gpExtend, next == gpZWJ: // GB9
+		return false
+	case next == gpSpacingMark: // GB9a
+		return false
+	case prev == gpPrepend: // GB9b
+		return false
+	case joiner && next == gpExtendedPictographic: // GB11
+		return false
+	case prev == gpRegionalIndicator && next == gpRegionalIndicator && regional%2 == 1: // GB12, GB13
+		return false
+	}
+	return true // GB999
+}
+
+// GraphemeSeq возвращает последовательность расширенных графемных кластеров строки (UAX #29):
+// символов в том виде, в каком их видит пользователь, — с диакритикой, модификаторами эмодзи,
+// флагами и последовательностями ZWJ.
+func GraphemeSeq(s string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for s != "" {
+			n := graphemeClusterLen(s)
+			if !yield(s[:n]) {
+				return
+			}
+			s = s[n:]
+		}
+	}
+}
+
+// Graphemes возвращает срез расширенных графемных кластеров строки.
+func Graphemes(s string) []string {
+	var clusters []string
+	for cluster := range GraphemeSeq(s) {
+		clusters = append(clusters, cluster)
+	}
+	return clusters
+}
+
+// GraphemeCount возвращает число видимых символов (расширенных графемных кластеров) строки.
+// В отличие от StringLength, флаг "🇷🇺", "é" из двух кодовых точек и "👨‍👩‍👧" считаются одним символом.
+func GraphemeCount(s string) int {
+	n := 0
+	for s != "" {
+		s = s[graphemeClusterLen(s):]
+		n++
+	}
+	return n
+}
@@ -0,0 +1,46 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestGraphemes(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want []string
+	}{
+		{"ASCII", "abc", []string{"a", "b", "c"}},
+		{"Кириллица", "Ёж", []string{"Ё", "ж"}},
+		{"Комбинируемые знаки", "été", []string{"é", "t", "é"}},
+		{"CRLF", "a\r\nb", []string{"a", "\r\n", "b"}},
+		{"Флаги", "🇷🇺🇺🇸🇩", []string{"🇷🇺", "🇺🇸", "🇩"}},
+		{"Модификатор тона", "👍🏽!", []string{"👍🏽", "!"}},
+		{"ZWJ-последовательность", "👨‍👩‍👧x", []string{"👨‍👩‍👧", "x"}},
+		{"Вариативный селектор", "❤️a", []string{"❤️", "a"}},
+		{"Клавиша", "1️⃣2", []string{"1️⃣", "2"}},
+		{"Флаг-тег", "🏴\U000E0067\U000E0062\U000E0065\U000E006E\U000E0067\U000E007F!", []string{"🏴\U000E0067\U000E0062\U000E0065\U000E006E\U000E0067\U000E007F", "!"}},
+		{"Хангыль", "각한", []string{"각", "한"}},
+		{"Деванагари", "नमस्ते", []string{"न", "म", "स्", "ते"}},
+		{"ZWJ без эмодзи", "a‍👍", []string{"a‍", "👍"}},
+		{"Некорректный UTF-8", "a\xffb", []string{"a", "\xff", "b"}},
+		{"Пустая строка", "", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Graphemes(tt.s)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Graphemes() = %q, want %q", got, tt.want)
+			}
+			if n := GraphemeCount(tt.s); n != len(tt.want) {
+				t.Errorf("GraphemeCount() = %d, want %d", n, len(tt.want))
+			}
+		})
+	}
+}
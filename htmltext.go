@@ -0,0 +1,361 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"html"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// HTMLLinkStyle определяет, как выводятся адреса ссылок при преобразовании HTML в текст.
+type HTMLLinkStyle int
+
+const (
+	// HTMLLinkInline — адрес в скобках сразу после текста ссылки: "текст (https://...)".
+	HTMLLinkInline HTMLLinkStyle = iota
+	// HTMLLinkFootnote — номер сноски после текста и список адресов в конце: "текст [1]".
+	HTMLLinkFootnote
+	// HTMLLinkNone — адреса ссылок не выводятся.
+	HTMLLinkNone
+)
+
+// HTMLToTextOptions — параметры преобразования HTML в текст.
+type HTMLToTextOptions struct {
+	// Width — ширина строки для переноса по словам; 0 — без переноса
+	Width int
+	// Links — способ вывода адресов ссылок
+	Links HTMLLinkStyle
+	// Bullet — маркер элементов ненумерованного списка; по умолчанию "* "
+	Bullet string
+}
+
+var (
+	// htmlParagraphTags — блочные элементы, отделяемые пустой строкой.
+	htmlParagraphTags = []string{"p", "h1", "h2", "h3", "h4", "h5", "h6", "ul", "ol", "dl", "blockquote", "pre", "table", "hr", "address", "figure"}
+
+	// htmlLineTags — блочные элементы, начинающиеся с новой строки.
+	htmlLineTags = []string{"div", "li", "tr", "dt", "dd", "section", "article", "header", "footer", "nav", "main", "aside", "figcaption", "caption", "form", "fieldset", "body", "html"}
+
+	// htmlTextLinkPolicy — схемы ссылок, адреса которых выводятся в тексте.
+	htmlTextLinkPolicy = &HTMLPolicy{AllowedURLSchemes: []string{"http", "https", "mailto", "ftp", "tel"}, AllowRelativeURLs: true}
+)
+
+// htmlListState — состояние открытого списка.
+type htmlListState struct {
+	ordered bool
+	counter int
+}
+
+// htmlTextWriter собирает текст из лексем HTML.
+type htmlTextWriter struct {
+	opts       HTMLToTextOptions
+	lines      []string
+	blank      bool // перед следующим блоком нужна пустая строка
+	blankQuote int  // уровень цитирования пустой строки
+
+	inline   strings.Builder
+	bullet   string // маркер, ожидающий первой строки элемента списка
+	lists    []htmlListState
+	quote    int
+	pre      int
+	heading  string
+	cellOpen bool
+
+	linkHref  string
+	linkStart int
+	footnotes []string
+}
+
+// HTMLToText преобразует HTML в читаемый текст с сохранением структуры:
+// абзацы и заголовки разделяются пустыми строками, списки получают маркеры
+// и номера, цитаты — префикс "> ", ячейки таблиц — разделитель " | ",
+// HTML-сущности декодируются, а адреса ссылок выводятся по выбранному стилю.
+func HTMLToText(s string, opts HTMLToTextOptions) string {
+	if opts.Bullet == "" {
+		opts.Bullet = "* "
+	}
+	w := &htmlTextWriter{opts: opts}
+
+	inHead := false
+	walkHTML(s, func(tok htmlToken) bool {
+		switch {
+		case tok.Type == htmlStartTagToken && tok.Name == "head":
+			inHead = true
+		case tok.Type == htmlEndTagToken && tok.Name == "head", tok.Type == htmlStartTagToken && tok.Name == "body":
+			inHead = false
+		}
+		if !inHead {
+			w.token(tok)
+		}
+		return true
+	})
+	w.flush()
+
+	if len(w.footnotes) > 0 {
+		w.blank = true
+		for i, href := range w.footnotes {
+			w.addLine("[" + strconv.Itoa(i+1) + "] " + href)
+		}
+	}
+	return strings.Join(w.lines, "\n")
+}
+
+// token обрабатывает одну лексему.
+func (w *htmlTextWriter) token(tok htmlToken) {
+	switch tok.Type {
+	case htmlTextToken:
+		text := html.UnescapeString(tok.Data)
+		if w.pre == 0 {
+			text = strings.ReplaceAll(text, "\u00a0", " ")
+		}
+		w.inline.WriteString(text)
+
+	case htmlStartTagToken:
+		w.startTag(tok)
+		if tok.SelfClosing && !slices.Contains(htmlVoidTags, tok.Name) {
+			w.endTag(tok.Name)
+		}
+
+	case htmlEndTagToken:
+		w.endTag(tok.Name)
+	}
+}
+
+// startTag обрабатывает открывающий тег.
+func (w *htmlTextWriter) startTag(tok htmlToken) {
+	switch tok.Name {
+	case "br":
+		w.flush()
+		return
+	case "hr":
+		w.paragraph()
+		width := w.opts.Width
+		if width <= 0 || width > 40 {
+			width = 40
+		}
+		w.addLine(strings.Repeat("-", width))
+		w.blank = true
+		return
+	case "img":
+		if alt, ok := tok.attr("alt"); ok && strings.TrimSpace(alt) != "" {
+			w.inline.WriteString("[" + strings.TrimSpace(alt) + "]")
+		}
+		return
+	case "a":
+		w.linkHref, _ = tok.attr("href")
+		w.linkHref = strings.TrimSpace(w.linkHref)
+		w.linkStart = w.inline.Len()
+		return
+	case "td", "th":
+		if w.cellOpen {
+			w.inline.WriteString(" | ")
+		}
+		w.cellOpen = true
+		return
+	case "tr":
+		w.flush()
+		w.cellOpen = false
+		return
+	}
+
+	w.blockBreak(tok.Name)
+
+	switch tok.Name {
+	case "ul", "ol":
+		list := htmlListState{ordered: tok.Name == "ol", counter: 1}
+		if start, ok := tok.attr("start"); ok {
+			if n, err := strconv.Atoi(strings.TrimSpace(start)); err == nil {
+				list.counter = n
+			}
+		}
+		w.lists = append(w.lists, list)
+	case "li":
+		if len(w.lists) == 0 {
+			w.bullet = w.opts.Bullet
+			break
+		}
+		list := &w.lists[len(w.lists)-1]
+		if list.ordered {
+			w.bullet = strconv.Itoa(list.counter) + ". "
+			list.counter++
+		} else {
+			w.bullet = w.opts.Bullet
+		}
+	case "blockquote":
+		w.quote++
+	case "pre":
+		w.pre++
+	case "h1", "h2":
+		w.heading = tok.Name
+	}
+}
+
+// endTag обрабатывает закрывающий тег.
+func (w *htmlTextWriter) endTag(name string) {
+	switch name {
+	case "a":
+		w.endLink()
+		return
+	case "td", "th":
+		return
+	case "tr":
+		w.flush()
+		w.cellOpen = false
+		return
+	}
+
+	w.blockBreak(name)
+
+	switch name {
+	case "ul", "ol":
+		if len(w.lists) > 0 {
+			w.lists = w.lists[:len(w.lists)-1]
+		}
+	case "li":
+		w.bullet = ""
+	case "blockquote":
+		if w.quote > 0 {
+			w.quote--
+		}
+	case "pre":
+		if w.pre > 0 {
+			w.pre--
+		}
+	}
+}
+
+// endLink дописывает адрес ссылки согласно настройкам.
+func (w *htmlTextWriter) endLink() {
+	href := w.linkHref
+	w.linkHref = ""
+	if href == "" || strings.HasPrefix(href, "#") || w.opts.Links == HTMLLinkNone {
+		return
+	}
+	if !htmlTextLinkPolicy.isAllowedURL(href) {
+		return
+	}
+
+	// Текст ссылки совпадает с адресом — повторять его не нужно
+	text := ""
+	if w.linkStart <= w.inline.Len() {
+		text = strings.TrimSpace(w.inline.String()[w.linkStart:])
+	}
+	if text == href || "mailto:"+text == href {
+		return
+	}
+
+	if w.opts.Links == HTMLLinkFootnote {
+		n := slices.Index(w.footnotes, href)
+		if n < 0 {
+			w.footnotes = append(w.footnotes, href)
+			n = len(w.footnotes) - 1
+		}
+		w.inline.WriteString(" [" + strconv.Itoa(n+1) + "]")
+		return
+	}
+	w.inline.WriteString(" (" + href + ")")
+}
+
+// blockBreak завершает текущую строку или абзац на границе блочного элемента.
+// Вложенные списки не отделяются от родительского пустой строкой.
+func (w *htmlTextWriter) blockBreak(name string) {
+	nestedList := (name == "ul" || name == "ol") && len(w.lists) > 0 && (len(w.lists) > 1 || w.bullet != "")
+	switch {
+	case slices.Contains(htmlParagraphTags, name) && !nestedList:
+		w.paragraph()
+	case slices.Contains(htmlParagraphTags, name), slices.Contains(htmlLineTags, name):
+		w.flush()
+	}
+}
+
+// paragraph завершает текущий блок и требует пустую строку перед следующим.
+func (w *htmlTextWriter) paragraph() {
+	w.flush()
+	if len(w.lines) > 0 {
+		if !w.blank || w.quote < w.blankQuote {
+			w.blankQuote = w.quote
+		}
+		w.blank = true
+	}
+}
+
+// prefix возвращает префикс строки с учетом цитат и вложенности списков.
+func (w *htmlTextWriter) prefix(first bool) string {
+	var b strings.Builder
+	for range w.quote {
+		b.WriteString("> ")
+	}
+	if n := len(w.lists); n > 1 {
+		b.WriteString(strings.Repeat("  ", n-1))
+	}
+	if w.bullet != "" {
+		if first {
+			b.WriteString(w.bullet)
+		} else {
+			b.WriteString(strings.Repeat(" ", DisplayWidth(w.bullet)))
+		}
+	}
+	return b.String()
+}
+
+// flush переносит накопленный текст в строки результата.
+func (w *htmlTextWriter) flush() {
+	raw := w.inline.String()
+	w.inline.Reset()
+	w.linkStart = 0
+
+	var lines []string
+	if w.pre > 0 {
+		lines = strings.Split(strings.Trim(raw, "\r\n"), "\n")
+		if len(lines) == 1 && strings.TrimSpace(lines[0]) == "" {
+			return
+		}
+	} else {
+		text := ClearString(raw)
+		if text == "" {
+			return
+		}
+		width := 0
+		if w.opts.Width > 0 {
+			width = max(w.opts.Width-DisplayWidth(w.prefix(true)), 10)
+		}
+		lines = wrapParagraph(text, width, width, false)
+	}
+
+	for i, line := range lines {
+		w.addLine(w.prefix(i == 0) + strings.TrimRight(line, " \t\r"))
+	}
+	if w.bullet != "" {
+		// Последующие строки элемента списка выравниваются по тексту
+		w.bullet = strings.Repeat(" ", DisplayWidth(w.bullet))
+	}
+
+	// Подчеркивание заголовков первого и второго уровня
+	if w.heading != "" {
+		underline := "="
+		if w.heading == "h2" {
+			underline = "-"
+		}
+		longest := 0
+		for _, line := range lines {
+			longest = max(longest, DisplayWidth(line))
+		}
+		w.addLine(w.prefix(false) + strings.Repeat(underline, longest))
+		w.heading = ""
+	}
+}
+
+// addLine добавляет строку результата, вставляя при необходимости пустую строку.
+func (w *htmlTextWriter) addLine(line string) {
+	if w.blank && len(w.lines) > 0 {
+		blankLine := strings.TrimRight(strings.Repeat("> ", min(w.quote, w.blankQuote)), " ")
+		w.lines = append(w.lines, blankLine)
+	}
+	w.blank = false
+	w.lines = append(w.lines, line)
+}
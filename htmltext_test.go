@@ -0,0 +1,112 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"testing"
+)
+
+func TestHTMLToText(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		opts HTMLToTextOptions
+		want string
+	}{
+		{
+			"Абзацы и сущности",
+			"<p>Tom &amp; Jerry&nbsp;&mdash; друзья</p><p>Второй\n   абзац</p>",
+			HTMLToTextOptions{},
+			"Tom & Jerry — друзья\n\nВторой абзац",
+		},
+		{
+			"Переносы строк",
+			"Строка 1<br>Строка 2<br/>Строка 3",
+			HTMLToTextOptions{},
+			"Строка 1\nСтрока 2\nСтрока 3",
+		},
+		{
+			"Заголовки",
+			"<h1>Отчёт</h1><h2>Итоги</h2><h3>Детали</h3><p>Текст</p>",
+			HTMLToTextOptions{},
+			"Отчёт\n=====\n\nИтоги\n-----\n\nДетали\n\nТекст",
+		},
+		{
+			"Списки",
+			`<ul><li>Один</li><li>Два<ol start="3"><li>Три</li><li>Четыре</li></ol></li></ul>`,
+			HTMLToTextOptions{},
+			"* Один\n* Два\n  3. Три\n  4. Четыре",
+		},
+		{
+			"Пользовательский маркер",
+			`<ul><li>A</li><li>B</li></ul>`,
+			HTMLToTextOptions{Bullet: "• "},
+			"• A\n• B",
+		},
+		{
+			"Ссылки в тексте",
+			`<p>См. <a href="https://example.com/a">документацию</a> и <a href="https://example.com">https://example.com</a>, <a href="mailto:info@example.com">info@example.com</a>, <a href="#top">наверх</a>, <a href="javascript:alert(1)">x</a></p>`,
+			HTMLToTextOptions{},
+			"См. документацию (https://example.com/a) и https://example.com, info@example.com, наверх, x",
+		},
+		{
+			"Ссылки сносками",
+			`<p><a href="https://a.ru">Первая</a>, <a href="https://b.ru">вторая</a> и снова <a href="https://a.ru">первая</a></p>`,
+			HTMLToTextOptions{Links: HTMLLinkFootnote},
+			"Первая [1], вторая [2] и снова первая [1]\n\n[1] https://a.ru\n[2] https://b.ru",
+		},
+		{
+			"Без адресов ссылок",
+			`<a href="https://a.ru">Ссылка</a>`,
+			HTMLToTextOptions{Links: HTMLLinkNone},
+			"Ссылка",
+		},
+		{
+			"Таблица",
+			`<table><tr><th>Имя</th><th>Возраст</th></tr><tr><td>Иван</td><td>30</td></tr></table><p>После</p>`,
+			HTMLToTextOptions{},
+			"Имя | Возраст\nИван | 30\n\nПосле",
+		},
+		{
+			"Цитата",
+			`<p>Он сказал:</p><blockquote><p>Первый</p><p>Второй</p></blockquote>`,
+			HTMLToTextOptions{},
+			"Он сказал:\n\n> Первый\n>\n> Второй",
+		},
+		{
+			"Предформатированный текст",
+			"<pre>  a  b\n    c</pre>",
+			HTMLToTextOptions{},
+			"  a  b\n    c",
+		},
+		{
+			"Перенос по ширине",
+			`<p>Съешь же ещё этих мягких французских булок, да выпей чаю</p><ul><li>Пункт списка с длинным текстом</li></ul>`,
+			HTMLToTextOptions{Width: 20},
+			"Съешь же ещё этих\nмягких французских\nбулок, да выпей чаю\n\n* Пункт списка с\n  длинным текстом",
+		},
+		{
+			"Широкий маркер списка",
+			`<ul><li>Пункт списка номер один</li></ul>`,
+			HTMLToTextOptions{Width: 20, Bullet: "\U0001F449 "},
+			"\U0001F449 Пункт списка\n   номер один",
+		},
+		{
+			"Служебные элементы",
+			`<html><head><title>Письмо</title><style>p{}</style></head><body><script>x()</script><div>Текст <img src="a.png" alt="логотип"></div><hr><div>Подвал</div></body></html>`,
+			HTMLToTextOptions{Width: 10},
+			"Текст\n[логотип]\n\n----------\n\nПодвал",
+		},
+		{"Пустой ввод", "", HTMLToTextOptions{}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HTMLToText(tt.s, tt.opts); got != tt.want {
+				t.Errorf("HTMLToText() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,313 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"errors"
+	"net/netip"
+	"slices"
+	"strings"
+)
+
+var (
+	// ErrInvalidIPRange возвращается, если строка не является IP-адресом, CIDR или диапазоном.
+	ErrInvalidIPRange = errors.New("helpers: некорректный диапазон IP-адресов")
+	// ErrMixedIPFamilies возвращается, если границы диапазона относятся к разным версиям IP.
+	ErrMixedIPFamilies = errors.New("helpers: границы диапазона относятся к разным версиям IP")
+)
+
+// IPRange описывает непрерывный диапазон IP-адресов [From, To] одной версии протокола.
+type IPRange struct {
+	From netip.Addr
+	To   netip.Addr
+}
+
+// ParseIPRange разбирает одиночный IP-адрес, CIDR (10.0.0.0/8)
+// или диапазон через дефис (10.0.0.1-10.0.0.50).
+func ParseIPRange(s string) (IPRange, error) {
+	s = strings.TrimSpace(s)
+
+	// Одиночный адрес; зона IPv6 может содержать дефис: "fe80::1%eth-0"
+	if addr, err := netip.ParseAddr(s); err == nil {
+		addr = addr.Unmap().WithZone("")
+		return IPRange{From: addr, To: addr}, nil
+	}
+
+	// CIDR
+	if strings.Contains(s, "/") {
+		prefix, err := netip.ParsePrefix(s)
+		if err != nil {
+			return IPRange{}, ErrInvalidIPRange
+		}
+		return PrefixToIPRange(prefix), nil
+	}
+
+	// Диапазон через дефис
+	from, to, ok := strings.Cut(s, "-")
+	if !ok {
+		return IPRange{}, ErrInvalidIPRange
+	}
+	fromAddr, err := netip.ParseAddr(strings.TrimSpace(from))
+	if err != nil {
+		return IPRange{}, ErrInvalidIPRange
+	}
+	toAddr, err := netip.ParseAddr(strings.TrimSpace(to))
+	if err != nil {
+		return IPRange{}, ErrInvalidIPRange
+	}
+	fromAddr, toAddr = fromAddr.Unmap(), toAddr.Unmap()
+	if fromAddr.Is4() != toAddr.Is4() {
+		return IPRange{}, ErrMixedIPFamilies
+	}
+	if toAddr.Less(fromAddr) {
+		return IPRange{}, ErrInvalidIPRange
+	}
+	return IPRange{From: fromAddr.WithZone(""), To: toAddr.WithZone("")}, nil
+}
+
+// PrefixToIPRange преобразует CIDR-префикс в диапазон адресов.
+func PrefixToIPRange(prefix netip.Prefix) IPRange {
+	prefix = prefix.Masked()
+	if prefix.Addr().Is4In6() && prefix.Bits() >= 96 {
+		prefix = netip.PrefixFrom(prefix.Addr().Unmap(), prefix.Bits()-96)
+	}
+	return IPRange{From: prefix.Addr(), To: prefixLastAddr(prefix)}
+}
+
+// IsCIDR проверяет, является ли строка валидной записью CIDR (например, 192.168.0.0/16).
+func IsCIDR(s string) bool {
+	_, err := netip.ParsePrefix(s)
+	return err == nil
+}
+
+// IsIPRange проверяет, является ли строка валидным диапазоном IP-адресов через дефис.
+func IsIPRange(s string) bool {
+	if !strings.Contains(s, "-") {
+		return false
+	}
+	_, err := ParseIPRange(s)
+	return err == nil
+}
+
+// IsValid сообщает, задан ли диапазон корректно.
+func (r IPRange) IsValid() bool {
+	return r.From.IsValid() && r.To.IsValid() && r.From.BitLen() == r.To.BitLen() && !r.To.Less(r.From)
+}
+
+// Contains проверяет, входит ли адрес в диапазон.
+func (r IPRange) Contains(addr netip.Addr) bool {
+	addr = addr.Unmap().WithZone("")
+	return r.From.Compare(addr) <= 0 && addr.Compare(r.To) <= 0
+}
+
+// String возвращает диапазон в виде "from-to" или одиночного адреса.
+func (r IPRange) String() string {
+	if r.From == r.To {
+		return r.From.String()
+	}
+	return r.From.String() + "-" + r.To.String()
+}
+
+// Prefixes раскладывает диапазон на минимальный набор CIDR-префиксов.
+func (r IPRange) Prefixes() []netip.Prefix {
+	if !r.IsValid() {
+		return nil
+	}
+
+	var prefixes []netip.Prefix
+	from := r.From
+	for {
+		// Подбираем самый крупный выровненный блок, начинающийся с from и не выходящий за To
+		prefix := netip.PrefixFrom(from, from.BitLen())
+		for bits := 0; bits <= from.BitLen(); bits++ {
+			p := netip.PrefixFrom(from, bits)
+			if p.Masked().Addr() == from && prefixLastAddr(p).Compare(r.To) <= 0 {
+				prefix = p
+				break
+			}
+		}
+		prefixes = append(prefixes, prefix)
+
+		last := prefixLastAddr(prefix)
+		if last == r.To {
+			return prefixes
+		}
+		from = last.Next()
+	}
+}
+
+// IPRangeToCIDRs раскладывает диапазон from-to на минимальный набор CIDR-префиксов.
+func IPRangeToCIDRs(from, to string) ([]netip.Prefix, error) {
+	r, err := ParseIPRange(from + "-" + to)
+	if err != nil {
+		return nil, err
+	}
+	return r.Prefixes(), nil
+}
+
+// prefixLastAddr возвращает последний адрес префикса.
+func prefixLastAddr(prefix netip.Prefix) netip.Addr {
+	addr := prefix.Masked().Addr()
+	bits := prefix.Bits()
+	if addr.Is4() {
+		b := addr.As4()
+		setHostBits(b[:], bits)
+		return netip.AddrFrom4(b)
+	}
+	b := addr.As16()
+	setHostBits(b[:], bits)
+	return netip.AddrFrom16(b)
+}
+
+// setHostBits выставляет в единицу все биты после первых bits бит.
+func setHostBits(b []byte, bits int) {
+	for i := range b {
+		switch {
+		case bits >= 8:
+			bits -= 8
+		case bits > 0:
+			b[i] |= 0xff >> bits
+			bits = 0
+		default:
+			b[i] = 0xff
+		}
+	}
+}
+
+// IPSet — множество IP-адресов из объединенных непересекающихся диапазонов.
+// Проверка Contains выполняется двоичным поиском за O(log n).
+// Чтение из нескольких горутин безопасно, если множество не изменяется.
+type IPSet struct {
+	ranges []IPRange
+}
+
+// NewIPSet создает множество из списка адресов, CIDR и диапазонов через дефис.
+// Пустые строки и строки, начинающиеся с #, пропускаются.
+func NewIPSet(entries ...string) (*IPSet, error) {
+	s := &IPSet{}
+	for _, entry := range entries {
+		if err := s.AddString(entry); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// AddString добавляет в множество адрес, CIDR или диапазон через дефис.
+func (s *IPSet) AddString(entry string) error {
+	entry = strings.TrimSpace(entry)
+	if entry == "" || strings.HasPrefix(entry, "#") {
+		return nil
+	}
+	r, err := ParseIPRange(entry)
+	if err != nil {
+		return err
+	}
+	s.AddRange(r)
+	return nil
+}
+
+// AddPrefix добавляет в множество CIDR-префикс.
+func (s *IPSet) AddPrefix(prefix netip.Prefix) {
+	if prefix.IsValid() {
+		s.AddRange(PrefixToIPRange(prefix))
+	}
+}
+
+// AddRange добавляет в множество диапазон, объединяя его с пересекающимися и смежными.
+func (s *IPSet) AddRange(r IPRange) {
+	if !r.IsValid() {
+		return
+	}
+
+	// Первый диапазон, который может пересекаться с новым или примыкать к нему
+	i, _ := slices.BinarySearchFunc(s.ranges, r.From, func(e IPRange, addr netip.Addr) int {
+		if next := e.To.Next(); next.IsValid() && next.BitLen() == e.To.BitLen() {
+			return next.Compare(addr)
+		}
+		return e.To.Compare(addr)
+	})
+
+	// Поглощаем все диапазоны, которые пересекаются с новым или примыкают к нему
+	j := i
+	for j < len(s.ranges) && rangesTouch(r, s.ranges[j]) {
+		if s.ranges[j].From.Less(r.From) {
+			r.From = s.ranges[j].From
+		}
+		if r.To.Less(s.ranges[j].To) {
+			r.To = s.ranges[j].To
+		}
+		j++
+	}
+	s.ranges = slices.Replace(s.ranges, i, j, r)
+}
+
+// rangesTouch сообщает, пересекаются ли диапазоны или следуют друг за другом без промежутка.
+func rangesTouch(a, b IPRange) bool {
+	if a.From.BitLen() != b.From.BitLen() {
+		return false
+	}
+	if b.From.Less(a.From) {
+		a, b = b, a
+	}
+	if b.From.Compare(a.To) <= 0 {
+		return true
+	}
+	next := a.To.Next()
+	return next.IsValid() && next == b.From
+}
+
+// Contains проверяет, входит ли адрес в множество.
+func (s *IPSet) Contains(addr netip.Addr) bool {
+	if s == nil || !addr.IsValid() {
+		return false
+	}
+	addr = addr.Unmap().WithZone("")
+	i, found := slices.BinarySearchFunc(s.ranges, addr, func(e IPRange, a netip.Addr) int {
+		return e.From.Compare(a)
+	})
+	if found {
+		return true
+	}
+	return i > 0 && s.ranges[i-1].Contains(addr)
+}
+
+// ContainsString проверяет, входит ли адрес, заданный строкой, в множество.
+func (s *IPSet) ContainsString(ip string) bool {
+	addr, err := netip.ParseAddr(strings.TrimSpace(ip))
+	if err != nil {
+		return false
+	}
+	return s.Contains(addr)
+}
+
+// Len возвращает количество непересекающихся диапазонов в множестве.
+func (s *IPSet) Len() int {
+	if s == nil {
+		return 0
+	}
+	return len(s.ranges)
+}
+
+// Ranges возвращает копию отсортированного списка объединенных диапазонов.
+func (s *IPSet) Ranges() []IPRange {
+	if s == nil {
+		return nil
+	}
+	return slices.Clone(s.ranges)
+}
+
+// Prefixes возвращает минимальный набор CIDR-префиксов, покрывающий множество.
+func (s *IPSet) Prefixes() []netip.Prefix {
+	if s == nil {
+		return nil
+	}
+	var prefixes []netip.Prefix
+	for _, r := range s.ranges {
+		prefixes = append(prefixes, r.Prefixes()...)
+	}
+	return prefixes
+}
@@ -0,0 +1,192 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"errors"
+	"net/netip"
+	"slices"
+	"testing"
+)
+
+func TestParseIPRange(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr error
+	}{
+		{"1", "10.0.0.1", "10.0.0.1", nil},
+		{"2", "10.0.0.0/8", "10.0.0.0-10.255.255.255", nil},
+		{"3", "10.0.0.1/8", "10.0.0.0-10.255.255.255", nil},
+		{"4", "10.0.0.1-10.0.0.50", "10.0.0.1-10.0.0.50", nil},
+		{"5", " 10.0.0.1 - 10.0.0.50 ", "10.0.0.1-10.0.0.50", nil},
+		{"6", "2001:db8::/126", "2001:db8::-2001:db8::3", nil},
+		{"7", "::ffff:192.168.0.1", "192.168.0.1", nil},
+		{"8", "::ffff:10.0.0.0/104", "10.0.0.0-10.255.255.255", nil},
+		{"9", "10.0.0.50-10.0.0.1", "", ErrInvalidIPRange},
+		{"10", "10.0.0.1-2001:db8::1", "", ErrMixedIPFamilies},
+		{"11", "10.0.0.0/33", "", ErrInvalidIPRange},
+		{"12", "not-an-ip", "", ErrInvalidIPRange},
+		{"13", "", "", ErrInvalidIPRange},
+		{"14", "fe80::1%eth-0", "fe80::1", nil},
+		{"15", "fe80::1 - fe80::5%eth-0", "fe80::1-fe80::5", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseIPRange(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseIPRange() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("ParseIPRange() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsCIDRAndIsIPRange(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		wantCIDR  bool
+		wantRange bool
+	}{
+		{"1", "192.168.0.0/16", true, false},
+		{"2", "2001:db8::/32", true, false},
+		{"3", "192.168.0.1", false, false},
+		{"4", "192.168.0.1-192.168.0.9", false, true},
+		{"5", "192.168.0.0/40", false, false},
+		{"6", "192.168.0.9-192.168.0.1", false, false},
+		{"7", "", false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsCIDR(tt.input); got != tt.wantCIDR {
+				t.Errorf("IsCIDR() = %v, want %v", got, tt.wantCIDR)
+			}
+			if got := IsIPRange(tt.input); got != tt.wantRange {
+				t.Errorf("IsIPRange() = %v, want %v", got, tt.wantRange)
+			}
+		})
+	}
+}
+
+func TestIPRangeToCIDRs(t *testing.T) {
+	tests := []struct {
+		name string
+		from string
+		to   string
+		want []string
+	}{
+		{"1", "10.0.0.0", "10.0.0.255", []string{"10.0.0.0/24"}},
+		{"2", "10.0.0.1", "10.0.0.6", []string{"10.0.0.1/32", "10.0.0.2/31", "10.0.0.4/31", "10.0.0.6/32"}},
+		{"3", "0.0.0.0", "255.255.255.255", []string{"0.0.0.0/0"}},
+		{"4", "255.255.255.254", "255.255.255.255", []string{"255.255.255.254/31"}},
+		{"5", "2001:db8::", "2001:db8::ffff", []string{"2001:db8::/112"}},
+		{"6", "192.168.1.5", "192.168.1.5", []string{"192.168.1.5/32"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prefixes, err := IPRangeToCIDRs(tt.from, tt.to)
+			if err != nil {
+				t.Fatalf("IPRangeToCIDRs() error = %v", err)
+			}
+			got := make([]string, 0, len(prefixes))
+			for _, p := range prefixes {
+				got = append(got, p.String())
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("IPRangeToCIDRs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := IPRangeToCIDRs("10.0.0.2", "10.0.0.1"); err == nil {
+		t.Error("Ожидалась ошибка для обратного диапазона")
+	}
+}
+
+func TestIPSet(t *testing.T) {
+	set, err := NewIPSet(
+		"# офис",
+		"10.0.0.0/24",
+		"10.0.1.0-10.0.1.255", // смежный с предыдущим
+		"10.0.0.128/25",       // вложенный
+		"192.168.1.10",
+		"192.168.1.11",
+		"",
+		"2001:db8::/64",
+		"::ffff:172.16.0.1",
+	)
+	if err != nil {
+		t.Fatalf("NewIPSet() error = %v", err)
+	}
+
+	wantRanges := []string{
+		"10.0.0.0-10.0.1.255",
+		"172.16.0.1",
+		"192.168.1.10-192.168.1.11",
+		"2001:db8::-2001:db8::ffff:ffff:ffff:ffff",
+	}
+	var gotRanges []string
+	for _, r := range set.Ranges() {
+		gotRanges = append(gotRanges, r.String())
+	}
+	if !slices.Equal(gotRanges, wantRanges) {
+		t.Errorf("Ranges() = %v, want %v", gotRanges, wantRanges)
+	}
+	if set.Len() != len(wantRanges) {
+		t.Errorf("Len() = %d, want %d", set.Len(), len(wantRanges))
+	}
+
+	tests := []struct {
+		ip   string
+		want bool
+	}{
+		{"10.0.0.0", true},
+		{"10.0.1.255", true},
+		{"10.0.2.0", false},
+		{"9.255.255.255", false},
+		{"172.16.0.1", true},
+		{"::ffff:172.16.0.1", true},
+		{"192.168.1.11", true},
+		{"192.168.1.12", false},
+		{"2001:db8::1", true},
+		{"2001:db8:0:1::1", false},
+		{"fe80::1%eth0", false},
+		{"garbage", false},
+	}
+	for _, tt := range tests {
+		if got := set.ContainsString(tt.ip); got != tt.want {
+			t.Errorf("ContainsString(%q) = %v, want %v", tt.ip, got, tt.want)
+		}
+	}
+
+	wantPrefixes := []string{"10.0.0.0/23", "172.16.0.1/32", "192.168.1.10/31", "2001:db8::/64"}
+	var gotPrefixes []string
+	for _, p := range set.Prefixes() {
+		gotPrefixes = append(gotPrefixes, p.String())
+	}
+	if !slices.Equal(gotPrefixes, wantPrefixes) {
+		t.Errorf("Prefixes() = %v, want %v", gotPrefixes, wantPrefixes)
+	}
+
+	// Диапазон, объединяющий несколько существующих
+	set.AddPrefix(netip.MustParsePrefix("0.0.0.0/0"))
+	if set.Len() != 2 {
+		t.Errorf("После добавления 0.0.0.0/0 ожидалось 2 диапазона, получено %d", set.Len())
+	}
+
+	if _, err := NewIPSet("10.0.0.1", "bad"); err == nil {
+		t.Error("Ожидалась ошибка для некорректной записи")
+	}
+
+	var nilSet *IPSet
+	if nilSet.Contains(netip.MustParseAddr("10.0.0.1")) || nilSet.Len() != 0 || nilSet.Ranges() != nil || nilSet.Prefixes() != nil {
+		t.Error("Пустое множество не должно содержать адресов")
+	}
+}
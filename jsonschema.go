@@ -0,0 +1,532 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// jsonSchemaMaxDepth ограничивает глубину рекурсии при проверке глубоко вложенных документов.
+const jsonSchemaMaxDepth = 512
+
+// ErrInvalidJSONSchema возвращается, если схема не может быть скомпилирована.
+var ErrInvalidJSONSchema = errors.New("helpers: некорректная JSON Schema")
+
+// JSONSchema — скомпилированная JSON Schema (подмножество draft 2020-12).
+//
+// Поддерживаются ключевые слова: type, enum, const, required, properties,
+// additionalProperties, items, prefixItems, minItems, maxItems, minimum, maximum,
+// exclusiveMinimum, exclusiveMaximum, minLength, maxLength, pattern,
+// allOf, anyOf, oneOf, not, а также $ref на фрагменты того же документа ("#/$defs/name").
+// Неизвестные ключевые слова игнорируются.
+type JSONSchema struct {
+	root     any
+	patterns map[string]*regexp.Regexp
+}
+
+// JSONSchemaError описывает одно нарушение схемы.
+type JSONSchemaError struct {
+	// InstancePath — JSON Pointer на проверяемое значение ("" — корень документа)
+	InstancePath string
+	// Keyword — ключевое слово схемы, условие которого нарушено
+	Keyword string
+	// Message — описание ошибки
+	Message string
+}
+
+// Error возвращает описание ошибки вместе с путем к значению.
+func (e JSONSchemaError) Error() string {
+	path := e.InstancePath
+	if path == "" {
+		path = "/"
+	}
+	return path + ": " + e.Message
+}
+
+// CompileJSONSchema компилирует JSON Schema из строки.
+func CompileJSONSchema(schema string) (*JSONSchema, error) {
+	root, err := decodeJSONNumbers([]byte(schema))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidJSONSchema, err)
+	}
+
+	s := &JSONSchema{root: root, patterns: make(map[string]*regexp.Regexp)}
+	if err := s.compile(root, "#"); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// CompileJSONSchemaFS компилирует JSON Schema из файла файловой системы (например, embed.FS).
+func CompileJSONSchemaFS(fsys fs.FS, name string) (*JSONSchema, error) {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+	return CompileJSONSchema(string(data))
+}
+
+// MustCompileJSONSchema аналогична CompileJSONSchema, но паникует при ошибке.
+// Предназначена для инициализации глобальных переменных.
+func MustCompileJSONSchema(schema string) *JSONSchema {
+	s, err := CompileJSONSchema(schema)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// Validate проверяет JSON-строку на соответствие схеме.
+// Ошибка возвращается, если строка не является валидным JSON.
+func (s *JSONSchema) Validate(data string) ([]JSONSchemaError, error) {
+	instance, err := decodeJSONNumbers([]byte(data))
+	if err != nil {
+		return nil, err
+	}
+	return s.ValidateValue(instance), nil
+}
+
+// ValidateValue проверяет уже декодированное значение на соответствие схеме.
+// Числа могут быть представлены как json.Number, float64 или целыми типами.
+func (s *JSONSchema) ValidateValue(instance any) []JSONSchemaError {
+	var errs []JSONSchemaError
+	s.validate(s.root, instance, "", 0, make(map[string]bool), &errs)
+	return errs
+}
+
+// IsValid сообщает, соответствует ли JSON-строка схеме.
+func (s *JSONSchema) IsValid(data string) bool {
+	errs, err := s.Validate(data)
+	return err == nil && len(errs) == 0
+}
+
+// decodeJSONNumbers декодирует JSON, сохраняя числа как json.Number.
+func decodeJSONNumbers(data []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); err == nil {
+		return nil, errors.New("лишние данные после JSON-значения")
+	}
+	return v, nil
+}
+
+// compile проверяет корректность схемы и заранее компилирует регулярные выражения.
+func (s *JSONSchema) compile(node any, location string) error {
+	if _, ok := node.(bool); ok {
+		return nil
+	}
+	schema, ok := node.(map[string]any)
+	if !ok {
+		return fmt.Errorf("%w: %s: схема должна быть объектом или boolean", ErrInvalidJSONSchema, location)
+	}
+
+	if ref, ok := schema["$ref"]; ok {
+		refStr, ok := ref.(string)
+		if !ok {
+			return fmt.Errorf("%w: %s/$ref: ожидается строка", ErrInvalidJSONSchema, location)
+		}
+		if _, err := s.resolveRef(refStr); err != nil {
+			return fmt.Errorf("%w: %s/$ref: %v", ErrInvalidJSONSchema, location, err)
+		}
+	}
+
+	if t, ok := schema["type"]; ok {
+		types := schemaTypes(t)
+		if len(types) == 0 {
+			return fmt.Errorf("%w: %s/type: ожидается строка или массив строк", ErrInvalidJSONSchema, location)
+		}
+		for _, name := range types {
+			if !slices.Contains([]string{"null", "boolean", "object", "array", "number", "integer", "string"}, name) {
+				return fmt.Errorf("%w: %s/type: неизвестный тип %q", ErrInvalidJSONSchema, location, name)
+			}
+		}
+	}
+
+	if p, ok := schema["pattern"]; ok {
+		pattern, ok := p.(string)
+		if !ok {
+			return fmt.Errorf("%w: %s/pattern: ожидается строка", ErrInvalidJSONSchema, location)
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return fmt.Errorf("%w: %s/pattern: %v", ErrInvalidJSONSchema, location, err)
+		}
+		s.patterns[pattern] = re
+	}
+
+	// Вложенные схемы-объекты
+	for _, keyword := range []string{"properties", "$defs", "definitions"} {
+		if m, ok := schema[keyword].(map[string]any); ok {
+			for name, sub := range m {
+				if err := s.compile(sub, location+"/"+keyword+"/"+escapeJSONPointer(name)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	// Одиночные вложенные схемы
+	for _, keyword := range []string{"items", "additionalProperties", "not"} {
+		if sub, ok := schema[keyword]; ok {
+			if err := s.compile(sub, location+"/"+keyword); err != nil {
+				return err
+			}
+		}
+	}
+	// Списки вложенных схем
+	for _, keyword := range []string{"prefixItems", "allOf", "anyOf", "oneOf"} {
+		if sub, ok := schema[keyword]; ok {
+			list, ok := sub.([]any)
+			if !ok {
+				return fmt.Errorf("%w: %s/%s: ожидается массив", ErrInvalidJSONSchema, location, keyword)
+			}
+			for i, item := range list {
+				if err := s.compile(item, location+"/"+keyword+"/"+strconv.Itoa(i)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// resolveRef находит фрагмент документа по ссылке вида "#" или "#/json/pointer".
+func (s *JSONSchema) resolveRef(ref string) (any, error) {
+	if ref == "#" {
+		return s.root, nil
+	}
+	if !strings.HasPrefix(ref, "#/") {
+		return nil, fmt.Errorf("поддерживаются только ссылки внутри документа: %q", ref)
+	}
+
+	node := s.root
+	for token := range strings.SplitSeq(ref[2:], "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		switch v := node.(type) {
+		case map[string]any:
+			next, ok := v[token]
+			if !ok {
+				return nil, fmt.Errorf("ссылка не найдена: %q", ref)
+			}
+			node = next
+		case []any:
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(v) {
+				return nil, fmt.Errorf("ссылка не найдена: %q", ref)
+			}
+			node = v[i]
+		default:
+			return nil, fmt.Errorf("ссылка не найдена: %q", ref)
+		}
+	}
+	return node, nil
+}
+
+// validate рекурсивно проверяет значение instance по схеме node. refs содержит ссылки $ref,
+// которые проверяются в текущей цепочке вызовов, вместе с путем к значению.
+func (s *JSONSchema) validate(node, instance any, path string, depth int, refs map[string]bool, errs *[]JSONSchemaError) {
+	addErr := func(keyword, format string, args ...any) {
+		*errs = append(*errs, JSONSchemaError{InstancePath: path, Keyword: keyword, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if depth > jsonSchemaMaxDepth {
+		addErr("$ref", "превышена глубина проверки схемы")
+		return
+	}
+
+	if b, ok := node.(bool); ok {
+		if !b {
+			addErr("false", "значение запрещено схемой")
+		}
+		return
+	}
+	schema, ok := node.(map[string]any)
+	if !ok {
+		return
+	}
+
+	if ref, ok := schema["$ref"].(string); ok {
+		if target, err := s.resolveRef(ref); err == nil {
+			// Повторный переход по ссылке для того же значения не продвигается по документу
+			// и без этой проверки ветвился бы в anyOf и oneOf до предела глубины
+			key := ref + "\x00" + path
+			if refs[key] {
+				addErr("$ref", "циклическая ссылка %s", ref)
+				return
+			}
+			refs[key] = true
+			s.validate(target, instance, path, depth+1, refs, errs)
+			delete(refs, key)
+		}
+	}
+
+	if t, ok := schema["type"]; ok {
+		types := schemaTypes(t)
+		if !slices.ContainsFunc(types, func(name string) bool { return jsonTypeMatches(name, instance) }) {
+			addErr("type", "ожидается тип %s, получен %s", strings.Join(types, " или "), jsonTypeName(instance))
+		}
+	}
+
+	if enum, ok := schema["enum"].([]any); ok {
+		if !slices.ContainsFunc(enum, func(v any) bool { return jsonEqual(v, instance) }) {
+			addErr("enum", "значение не входит в список допустимых")
+		}
+	}
+	if c, ok := schema["const"]; ok && !jsonEqual(c, instance) {
+		addErr("const", "значение не равно ожидаемой константе")
+	}
+
+	// Сочетания схем
+	if list, ok := schema["allOf"].([]any); ok {
+		for _, sub := range list {
+			s.validate(sub, instance, path, depth+1, refs, errs)
+		}
+	}
+	if list, ok := schema["anyOf"].([]any); ok {
+		if s.countMatches(list, instance, path, depth, refs) == 0 {
+			addErr("anyOf", "значение не соответствует ни одной из схем")
+		}
+	}
+	if list, ok := schema["oneOf"].([]any); ok {
+		if n := s.countMatches(list, instance, path, depth, refs); n != 1 {
+			addErr("oneOf", "значение должно соответствовать ровно одной схеме, совпадений: %d", n)
+		}
+	}
+	if sub, ok := schema["not"]; ok {
+		if s.countMatches([]any{sub}, instance, path, depth, refs) == 1 {
+			addErr("not", "значение не должно соответствовать схеме")
+		}
+	}
+
+	switch v := instance.(type) {
+	case string:
+		length := StringLength(v)
+		if n, ok := schemaNumber(schema, "minLength"); ok && float64(length) < n {
+			addErr("minLength", "длина строки должна быть не меньше %s", formatSchemaNumber(n))
+		}
+		if n, ok := schemaNumber(schema, "maxLength"); ok && float64(length) > n {
+			addErr("maxLength", "длина строки должна быть не больше %s", formatSchemaNumber(n))
+		}
+		if pattern, ok := schema["pattern"].(string); ok {
+			if re := s.patterns[pattern]; re != nil && !re.MatchString(v) {
+				addErr("pattern", "строка не соответствует шаблону %q", pattern)
+			}
+		}
+
+	case map[string]any:
+		if required, ok := schema["required"].([]any); ok {
+			for _, r := range required {
+				if name, ok := r.(string); ok {
+					if _, exists := v[name]; !exists {
+						addErr("required", "отсутствует обязательное свойство %q", name)
+					}
+				}
+			}
+		}
+		properties, _ := schema["properties"].(map[string]any)
+		additional, hasAdditional := schema["additionalProperties"]
+		for _, name := range sortedKeys(v) {
+			childPath := path + "/" + escapeJSONPointer(name)
+			if sub, ok := properties[name]; ok {
+				s.validate(sub, v[name], childPath, depth+1, refs, errs)
+			} else if hasAdditional {
+				if b, ok := additional.(bool); ok && !b {
+					*errs = append(*errs, JSONSchemaError{InstancePath: childPath, Keyword: "additionalProperties", Message: "свойство не разрешено схемой"})
+				} else {
+					s.validate(additional, v[name], childPath, depth+1, refs, errs)
+				}
+			}
+		}
+
+	case []any:
+		if n, ok := schemaNumber(schema, "minItems"); ok && float64(len(v)) < n {
+			addErr("minItems", "массив должен содержать не меньше %s элементов", formatSchemaNumber(n))
+		}
+		if n, ok := schemaNumber(schema, "maxItems"); ok && float64(len(v)) > n {
+			addErr("maxItems", "массив должен содержать не больше %s элементов", formatSchemaNumber(n))
+		}
+		prefix, _ := schema["prefixItems"].([]any)
+		items, hasItems := schema["items"]
+		for i, item := range v {
+			childPath := path + "/" + strconv.Itoa(i)
+			if i < len(prefix) {
+				s.validate(prefix[i], item, childPath, depth+1, refs, errs)
+			} else if hasItems {
+				s.validate(items, item, childPath, depth+1, refs, errs)
+			}
+		}
+
+	default:
+		if num, ok := jsonToFloat(instance); ok {
+			if n, ok := schemaNumber(schema, "minimum"); ok && num < n {
+				addErr("minimum", "значение должно быть не меньше %s", formatSchemaNumber(n))
+			}
+			if n, ok := schemaNumber(schema, "maximum"); ok && num > n {
+				addErr("maximum", "значение должно быть не больше %s", formatSchemaNumber(n))
+			}
+			if n, ok := schemaNumber(schema, "exclusiveMinimum"); ok && num <= n {
+				addErr("exclusiveMinimum", "значение должно быть больше %s", formatSchemaNumber(n))
+			}
+			if n, ok := schemaNumber(schema, "exclusiveMaximum"); ok && num >= n {
+				addErr("exclusiveMaximum", "значение должно быть меньше %s", formatSchemaNumber(n))
+			}
+		}
+	}
+}
+
+// countMatches возвращает количество схем из списка, которым соответствует значение.
+func (s *JSONSchema) countMatches(list []any, instance any, path string, depth int, refs map[string]bool) int {
+	n := 0
+	for _, sub := range list {
+		var subErrs []JSONSchemaError
+		s.validate(sub, instance, path, depth+1, refs, &subErrs)
+		if len(subErrs) == 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// schemaTypes возвращает список типов из значения ключевого слова type.
+func schemaTypes(t any) []string {
+	switch v := t.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		types := make([]string, 0, len(v))
+		for _, item := range v {
+			if name, ok := item.(string); ok {
+				types = append(types, name)
+			}
+		}
+		return types
+	}
+	return nil
+}
+
+// jsonTypeName возвращает имя JSON-типа значения.
+func jsonTypeName(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case map[string]any:
+		return "object"
+	case []any:
+		return "array"
+	}
+	if num, ok := jsonToFloat(v); ok {
+		if num == math.Trunc(num) && !math.IsInf(num, 0) {
+			return "integer"
+		}
+		return "number"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+// jsonTypeMatches проверяет соответствие значения JSON-типу.
+func jsonTypeMatches(name string, v any) bool {
+	actual := jsonTypeName(v)
+	return actual == name || (name == "number" && actual == "integer")
+}
+
+// jsonToFloat приводит числовое значение к float64.
+func jsonToFloat(v any) (float64, bool) {
+	switch n := v.(type) {
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case uint:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case uint32:
+		return float64(n), true
+	}
+	return 0, false
+}
+
+// jsonEqual сравнивает два JSON-значения; числа сравниваются по величине.
+func jsonEqual(a, b any) bool {
+	if x, ok := jsonToFloat(a); ok {
+		y, ok := jsonToFloat(b)
+		return ok && x == y
+	}
+	switch x := a.(type) {
+	case map[string]any:
+		y, ok := b.(map[string]any)
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for k, xv := range x {
+			yv, ok := y[k]
+			if !ok || !jsonEqual(xv, yv) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		y, ok := b.([]any)
+		return ok && slices.EqualFunc(x, y, jsonEqual)
+	}
+	// Значения, переданные в ValidateValue, могут быть несравнимыми (срезы, карты других типов)
+	return reflect.DeepEqual(a, b)
+}
+
+// schemaNumber возвращает числовое значение ключевого слова схемы.
+func schemaNumber(schema map[string]any, keyword string) (float64, bool) {
+	v, ok := schema[keyword]
+	if !ok {
+		return 0, false
+	}
+	return jsonToFloat(v)
+}
+
+// formatSchemaNumber форматирует число для сообщения об ошибке.
+func formatSchemaNumber(n float64) string {
+	return strconv.FormatFloat(n, 'f', -1, 64)
+}
+
+// escapeJSONPointer экранирует сегмент JSON Pointer (RFC 6901).
+func escapeJSONPointer(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "~", "~0"), "/", "~1")
+}
+
+// sortedKeys возвращает ключи объекта в отсортированном порядке для стабильного вывода ошибок.
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,204 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"testing/fstest"
+)
+
+const testUserSchema = `{
+	"$schema": "https://json-schema.org/draft/2020-12/schema",
+	"type": "object",
+	"required": ["id", "email", "role"],
+	"properties": {
+		"id": {"type": "integer", "minimum": 1},
+		"email": {"type": "string", "pattern": "^[^@]+@[^@]+$", "maxLength": 64},
+		"name": {"type": "string", "minLength": 2},
+		"role": {"enum": ["admin", "user"]},
+		"age": {"type": "number", "exclusiveMinimum": 0, "maximum": 150},
+		"tags": {"type": "array", "items": {"type": "string"}, "maxItems": 3},
+		"address": {"$ref": "#/$defs/address"},
+		"note": {"type": ["string", "null"]}
+	},
+	"additionalProperties": false,
+	"$defs": {
+		"address": {
+			"type": "object",
+			"required": ["city"],
+			"properties": {"city": {"type": "string", "minLength": 1}}
+		}
+	}
+}`
+
+func TestJSONSchemaValidate(t *testing.T) {
+	schema := MustCompileJSONSchema(testUserSchema)
+
+	tests := []struct {
+		name string
+		data string
+		want []string // "путь keyword"
+	}{
+		{"Валидный документ", `{"id": 1, "email": "a@b.ru", "role": "user", "tags": ["x"], "address": {"city": "Москва"}, "note": null}`, nil},
+		{"Целое число в виде 1.0", `{"id": 1.0, "email": "a@b.ru", "role": "admin"}`, nil},
+		{"Не объект", `[1, 2]`, []string{" type"}},
+		{"Отсутствуют обязательные поля", `{"id": 1}`, []string{" required", " required"}},
+		{"Неверные типы и значения", `{"id": 0.5, "email": "nope", "role": "root"}`, []string{"/email pattern", "/id minimum", "/id type", "/role enum"}},
+		{"Вложенные ошибки", `{"id": 2, "email": "a@b", "role": "user", "tags": ["ok", 5, "a", "b"], "address": {}}`, []string{"/address required", "/tags maxItems", "/tags/1 type"}},
+		{"Лишнее свойство", `{"id": 2, "email": "a@b", "role": "user", "extra/key": true}`, []string{"/extra~1key additionalProperties"}},
+		{"Длина строки в символах", `{"id": 2, "email": "a@b", "role": "user", "name": "Я"}`, []string{"/name minLength"}},
+		{"Исключающий минимум", `{"id": 2, "email": "a@b", "role": "user", "age": 0}`, []string{"/age exclusiveMinimum"}},
+		{"Тип из списка", `{"id": 2, "email": "a@b", "role": "user", "note": 1}`, []string{"/note type"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs, err := schema.Validate(tt.data)
+			if err != nil {
+				t.Fatalf("Validate() error = %v", err)
+			}
+			var got []string
+			for _, e := range errs {
+				got = append(got, e.InstancePath+" "+e.Keyword)
+			}
+			slices.Sort(got)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", errs, tt.want)
+			}
+		})
+	}
+
+	if _, err := schema.Validate(`{"id": `); err == nil {
+		t.Error("Ожидалась ошибка для невалидного JSON")
+	}
+	if _, err := schema.Validate(`{} {}`); err == nil {
+		t.Error("Ожидалась ошибка для лишних данных после JSON")
+	}
+	if !schema.IsValid(`{"id": 3, "email": "x@y", "role": "admin"}`) || schema.IsValid(`{}`) {
+		t.Error("IsValid() вернула неверный результат")
+	}
+}
+
+func TestJSONSchemaCombinators(t *testing.T) {
+	schema := MustCompileJSONSchema(`{
+		"$defs": {"node": {"type": "object", "properties": {"children": {"type": "array", "items": {"$ref": "#/$defs/node"}}}, "required": ["v"]}},
+		"anyOf": [{"$ref": "#/$defs/node"}, {"type": "string"}],
+		"not": {"const": "forbidden"},
+		"oneOf": [{"type": "string", "maxLength": 3}, {"type": "string", "minLength": 2}, {"type": "object"}]
+	}`)
+
+	tests := []struct {
+		data string
+		want []string
+	}{
+		{`{"v": 1, "children": [{"v": 2, "children": []}]}`, nil},
+		{`"abcd"`, nil},
+		{`"ab"`, []string{"oneOf"}},
+		{`"forbidden"`, []string{"not"}},
+		{`{"children": [{}]}`, []string{"anyOf"}},
+		{`5`, []string{"anyOf", "oneOf"}},
+	}
+	for _, tt := range tests {
+		errs, err := schema.Validate(tt.data)
+		if err != nil {
+			t.Fatalf("Validate(%s) error = %v", tt.data, err)
+		}
+		var got []string
+		for _, e := range errs {
+			got = append(got, e.Keyword)
+		}
+		slices.Sort(got)
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("Validate(%s) = %v, want %v", tt.data, errs, tt.want)
+		}
+	}
+
+	// Булевы схемы и prefixItems
+	tuple := MustCompileJSONSchema(`{"prefixItems": [{"type": "integer"}, true], "items": false}`)
+	if errs := tuple.ValidateValue([]any{1, "x"}); len(errs) != 0 {
+		t.Errorf("ValidateValue() = %v, want нет ошибок", errs)
+	}
+	if errs := tuple.ValidateValue([]any{1.5, "x", nil}); len(errs) != 2 || errs[1].InstancePath != "/2" {
+		t.Errorf("ValidateValue() = %v, want 2 ошибки", errs)
+	}
+}
+
+func TestCompileJSONSchemaErrors(t *testing.T) {
+	invalid := []string{
+		`{`,
+		`[]`,
+		`{"type": "float"}`,
+		`{"pattern": "(["}`,
+		`{"pattern": 1}`,
+		`{"$ref": "#/$defs/missing"}`,
+		`{"$ref": "other.json#/a"}`,
+		`{"$ref": 5}`,
+		`{"properties": {"a": 5}}`,
+		`{"allOf": {}}`,
+	}
+	for _, s := range invalid {
+		if _, err := CompileJSONSchema(s); !errors.Is(err, ErrInvalidJSONSchema) {
+			t.Errorf("CompileJSONSchema(%s) error = %v, want %v", s, err, ErrInvalidJSONSchema)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustCompileJSONSchema() должна паниковать на некорректной схеме")
+		}
+	}()
+	MustCompileJSONSchema(`{"type": 1.5}`)
+}
+
+func TestJSONSchemaRecursion(t *testing.T) {
+	schema := MustCompileJSONSchema(`{"$defs": {"a": {"$ref": "#/$defs/a"}}, "$ref": "#/$defs/a"}`)
+	errs, err := schema.Validate(`1`)
+	if err != nil || len(errs) == 0 {
+		t.Errorf("Validate() = %v, %v, ожидалась ошибка циклической ссылки", errs, err)
+	}
+
+	// Без учета пройденных ссылок каждая ветвь anyOf удваивала бы число проверок до предела глубины
+	branching := MustCompileJSONSchema(`{
+		"$defs": {"a": {"anyOf": [{"$ref": "#/$defs/a"}, {"$ref": "#/$defs/a"}, {"type": "integer"}]}},
+		"$ref": "#/$defs/a"
+	}`)
+	if !branching.IsValid(`1`) || branching.IsValid(`"x"`) {
+		t.Error("IsValid() вернул неверный результат для ветвящейся циклической схемы")
+	}
+}
+
+func TestJSONSchemaUncomparableValue(t *testing.T) {
+	schema := MustCompileJSONSchema(`{"enum": ["a", null, [1]], "const": "a"}`)
+	if errs := schema.ValidateValue([]int{1}); len(errs) != 2 {
+		t.Errorf("ValidateValue() = %v, ожидались ошибки enum и const", errs)
+	}
+}
+
+func TestCompileJSONSchemaFS(t *testing.T) {
+	fsys := fstest.MapFS{"schemas/user.json": {Data: []byte(testUserSchema)}}
+	schema, err := CompileJSONSchemaFS(fsys, "schemas/user.json")
+	if err != nil {
+		t.Fatalf("CompileJSONSchemaFS() error = %v", err)
+	}
+	if !schema.IsValid(`{"id": 1, "email": "a@b", "role": "user"}`) {
+		t.Error("Ожидалось, что документ будет валидным")
+	}
+	if _, err := CompileJSONSchemaFS(fsys, "missing.json"); err == nil {
+		t.Error("Ожидалась ошибка для отсутствующего файла")
+	}
+}
+
+func TestJSONSchemaError(t *testing.T) {
+	e := JSONSchemaError{InstancePath: "", Keyword: "type", Message: "ошибка"}
+	if e.Error() != "/: ошибка" {
+		t.Errorf("Error() = %q", e.Error())
+	}
+	e.InstancePath = "/a/0"
+	if e.Error() != "/a/0: ошибка" {
+		t.Errorf("Error() = %q", e.Error())
+	}
+}
@@ -0,0 +1,483 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+var (
+	// ErrJSONSyntax — синтаксическая ошибка JSON.
+	ErrJSONSyntax = errors.New("helpers: синтаксическая ошибка JSON")
+	// ErrJSONTooLarge — размер документа превышает JSONLimits.MaxSize.
+	ErrJSONTooLarge = errors.New("helpers: превышен максимальный размер JSON")
+	// ErrJSONTooDeep — вложенность превышает JSONLimits.MaxDepth.
+	ErrJSONTooDeep = errors.New("helpers: превышена максимальная вложенность JSON")
+	// ErrJSONStringTooLong — строка длиннее JSONLimits.MaxStringLength.
+	ErrJSONStringTooLong = errors.New("helpers: превышена максимальная длина строки JSON")
+	// ErrJSONDuplicateKey — повторяющийся ключ в объекте.
+	ErrJSONDuplicateKey = errors.New("helpers: повторяющийся ключ JSON")
+	// ErrJSONTrailingData — данные после завершения JSON-значения.
+	ErrJSONTrailingData = errors.New("helpers: лишние данные после JSON")
+)
+
+// DefaultJSONLimits — разумные ограничения для проверки тел HTTP-запросов.
+var DefaultJSONLimits = JSONLimits{
+	MaxDepth:            64,
+	MaxSize:             10 << 20, // 10 MB
+	MaxStringLength:     1 << 20,
+	RejectDuplicateKeys: true,
+}
+
+// JSONLimits задает ограничения потоковой проверки JSON. Нулевое значение поля означает отсутствие ограничения.
+type JSONLimits struct {
+	// MaxDepth — максимальная вложенность массивов и объектов
+	MaxDepth int
+	// MaxSize — максимальный размер документа в байтах
+	MaxSize int64
+	// MaxStringLength — максимальная длина строки (включая ключи) в символах после раскрытия escape-последовательностей
+	MaxStringLength int
+	// RejectDuplicateKeys — запрещает повторяющиеся ключи внутри одного объекта
+	RejectDuplicateKeys bool
+}
+
+// JSONStreamError описывает первое нарушение, найденное при проверке.
+type JSONStreamError struct {
+	// Offset — смещение в байтах от начала потока
+	Offset int64
+	// Err — одна из ошибок ErrJSON*
+	Err error
+	// Detail — уточнение
+	Detail string
+}
+
+// Error возвращает описание ошибки со смещением.
+func (e *JSONStreamError) Error() string {
+	if e.Detail == "" {
+		return fmt.Sprintf("%v (смещение %d)", e.Err, e.Offset)
+	}
+	return fmt.Sprintf("%v: %s (смещение %d)", e.Err, e.Detail, e.Offset)
+}
+
+// Unwrap позволяет сравнивать ошибку с ErrJSON* через errors.Is.
+func (e *JSONStreamError) Unwrap() error {
+	return e.Err
+}
+
+// ValidateJSONStream проверяет, что поток содержит ровно одно валидное JSON-значение,
+// не нарушающее ограничений. Документ не загружается в память целиком.
+// При нарушении возвращается *JSONStreamError со смещением первого проблемного байта;
+// ошибки чтения из r возвращаются как есть.
+func ValidateJSONStream(r io.Reader, limits JSONLimits) error {
+	s := &jsonStreamScanner{r: bufio.NewReader(r), limits: limits}
+	return s.scan()
+}
+
+// IsJSONWithLimits проверяет строку аналогично IsJSON, но без полного декодирования и с ограничениями.
+func IsJSONWithLimits(s string, limits JSONLimits) (bool, error) {
+	if err := ValidateJSONStream(strings.NewReader(s), limits); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// jsonStreamFrame — открытый массив или объект.
+type jsonStreamFrame struct {
+	object bool
+	keys   map[string]struct{}
+}
+
+// jsonStreamScanner — побайтовый валидирующий сканер JSON.
+type jsonStreamScanner struct {
+	r      *bufio.Reader
+	limits JSONLimits
+	offset int64 // смещение следующего байта
+	stack  []jsonStreamFrame
+}
+
+// fail формирует ошибку с указанным смещением.
+func (s *jsonStreamScanner) fail(offset int64, err error, detail string) error {
+	return &JSONStreamError{Offset: offset, Err: err, Detail: detail}
+}
+
+// next читает следующий байт. Конец потока возвращается как io.EOF.
+func (s *jsonStreamScanner) next() (byte, error) {
+	c, err := s.r.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+	if s.limits.MaxSize > 0 && s.offset >= s.limits.MaxSize {
+		return 0, s.fail(s.limits.MaxSize, ErrJSONTooLarge, "")
+	}
+	s.offset++
+	return c, nil
+}
+
+// unread возвращает последний прочитанный байт в поток.
+func (s *jsonStreamScanner) unread() {
+	_ = s.r.UnreadByte()
+	s.offset--
+}
+
+// nextNonSpace пропускает пробельные символы и возвращает первый значимый байт.
+func (s *jsonStreamScanner) nextNonSpace() (byte, error) {
+	for {
+		c, err := s.next()
+		if err != nil {
+			return 0, err
+		}
+		if c != ' ' && c != '\t' && c != '\n' && c != '\r' {
+			return c, nil
+		}
+	}
+}
+
+// unexpected формирует ошибку для неожиданного байта или конца потока.
+func (s *jsonStreamScanner) unexpected(err error, c byte) error {
+	if errors.Is(err, io.EOF) {
+		return s.fail(s.offset, ErrJSONSyntax, "неожиданный конец данных")
+	}
+	if err != nil {
+		return err
+	}
+	return s.fail(s.offset-1, ErrJSONSyntax, fmt.Sprintf("неожиданный символ %q", c))
+}
+
+// scan проверяет весь поток.
+func (s *jsonStreamScanner) scan() error {
+	needValue := true
+	for {
+		for needValue {
+			more, err := s.value()
+			if err != nil {
+				return err
+			}
+			needValue = more
+		}
+
+		if len(s.stack) == 0 {
+			// Значение завершено: дальше допускаются только пробелы
+			_, err := s.nextNonSpace()
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			return s.fail(s.offset-1, ErrJSONTrailingData, "")
+		}
+
+		c, err := s.nextNonSpace()
+		if err != nil {
+			return s.unexpected(err, c)
+		}
+		top := &s.stack[len(s.stack)-1]
+		switch {
+		case c == ',' && top.object:
+			if err := s.key(top); err != nil {
+				return err
+			}
+			needValue = true
+		case c == ',':
+			needValue = true
+		case c == '}' && top.object, c == ']' && !top.object:
+			s.stack = s.stack[:len(s.stack)-1]
+		default:
+			return s.unexpected(nil, c)
+		}
+	}
+}
+
+// value читает скалярное значение или открывает массив/объект.
+// Возвращает true, если открыт непустой контейнер и следом ожидается его первое значение.
+func (s *jsonStreamScanner) value() (bool, error) {
+	c, err := s.nextNonSpace()
+	if err != nil {
+		return false, s.unexpected(err, c)
+	}
+	start := s.offset - 1
+
+	switch {
+	case c == '{' || c == '[':
+		if s.limits.MaxDepth > 0 && len(s.stack) >= s.limits.MaxDepth {
+			return false, s.fail(start, ErrJSONTooDeep, "")
+		}
+		frame := jsonStreamFrame{object: c == '{'}
+		if frame.object && s.limits.RejectDuplicateKeys {
+			frame.keys = make(map[string]struct{})
+		}
+		s.stack = append(s.stack, frame)
+
+		// Пустой контейнер
+		closing := byte(']')
+		if frame.object {
+			closing = '}'
+		}
+		c, err := s.nextNonSpace()
+		if err != nil {
+			return false, s.unexpected(err, c)
+		}
+		if c == closing {
+			s.stack = s.stack[:len(s.stack)-1]
+			return false, nil
+		}
+		s.unread()
+		if frame.object {
+			return true, s.key(&s.stack[len(s.stack)-1])
+		}
+		return true, nil
+	case c == '"':
+		_, err := s.str(start, false)
+		return false, err
+	case c == '-' || (c >= '0' && c <= '9'):
+		s.unread()
+		return false, s.number()
+	case c == 't':
+		return false, s.literal("rue")
+	case c == 'f':
+		return false, s.literal("alse")
+	case c == 'n':
+		return false, s.literal("ull")
+	}
+	return false, s.unexpected(nil, c)
+}
+
+// key читает ключ объекта вместе с двоеточием и проверяет его уникальность.
+func (s *jsonStreamScanner) key(frame *jsonStreamFrame) error {
+	c, err := s.nextNonSpace()
+	if err != nil || c != '"' {
+		return s.unexpected(err, c)
+	}
+	start := s.offset - 1
+	key, err := s.str(start, frame.keys != nil)
+	if err != nil {
+		return err
+	}
+	if frame.keys != nil {
+		if _, exists := frame.keys[key]; exists {
+			return s.fail(start, ErrJSONDuplicateKey, fmt.Sprintf("%q", key))
+		}
+		frame.keys[key] = struct{}{}
+	}
+
+	c, err = s.nextNonSpace()
+	if err != nil || c != ':' {
+		return s.unexpected(err, c)
+	}
+	return nil
+}
+
+// str читает строку после открывающей кавычки. Если decode == true, возвращает ее значение.
+func (s *jsonStreamScanner) str(start int64, decode bool) (string, error) {
+	var b strings.Builder
+	length := 0
+	var pendingHigh rune // старший суррогат, ожидающий пары
+
+	for {
+		c, err := s.next()
+		if err != nil {
+			return "", s.unexpected(err, c)
+		}
+
+		var r rune = -1
+		switch {
+		case c == '"':
+			if pendingHigh != 0 && decode {
+				b.WriteRune(utf8.RuneError)
+			}
+			return b.String(), nil
+		case c < 0x20:
+			return "", s.fail(s.offset-1, ErrJSONSyntax, "управляющий символ в строке")
+		case c == '\\':
+			e, err := s.next()
+			if err != nil {
+				return "", s.unexpected(err, e)
+			}
+			switch e {
+			case '"', '\\', '/':
+				r = rune(e)
+			case 'b':
+				r = '\b'
+			case 'f':
+				r = '\f'
+			case 'n':
+				r = '\n'
+			case 'r':
+				r = '\r'
+			case 't':
+				r = '\t'
+			case 'u':
+				var code rune
+				for range 4 {
+					h, err := s.next()
+					if err != nil {
+						return "", s.unexpected(err, h)
+					}
+					d := hexDigitValue(h)
+					if d < 0 {
+						return "", s.unexpected(nil, h)
+					}
+					code = code<<4 | rune(d)
+				}
+				// Суррогатная пара считается одним символом
+				if pendingHigh != 0 && utf16.IsSurrogate(code) && code >= 0xDC00 {
+					if decode {
+						b.WriteRune(utf16.DecodeRune(pendingHigh, code))
+					}
+					pendingHigh = 0
+					continue
+				}
+				if pendingHigh != 0 && decode {
+					b.WriteRune(utf8.RuneError)
+				}
+				pendingHigh = 0
+				if code >= 0xD800 && code < 0xDC00 {
+					pendingHigh = code
+					length++
+					if err := s.checkStringLength(start, length); err != nil {
+						return "", err
+					}
+					continue
+				}
+				r = code
+			default:
+				return "", s.unexpected(nil, e)
+			}
+		}
+
+		if pendingHigh != 0 {
+			if decode {
+				b.WriteRune(utf8.RuneError)
+			}
+			pendingHigh = 0
+		}
+
+		if r >= 0 {
+			length++
+			if decode {
+				b.WriteRune(r)
+			}
+		} else {
+			// Обычный байт: считаем символы по ведущим байтам UTF-8
+			if c&0xC0 != 0x80 {
+				length++
+			}
+			if decode {
+				b.WriteByte(c)
+			}
+		}
+		if err := s.checkStringLength(start, length); err != nil {
+			return "", err
+		}
+	}
+}
+
+// checkStringLength проверяет ограничение длины строки.
+func (s *jsonStreamScanner) checkStringLength(start int64, length int) error {
+	if s.limits.MaxStringLength > 0 && length > s.limits.MaxStringLength {
+		return s.fail(start, ErrJSONStringTooLong, "")
+	}
+	return nil
+}
+
+// number проверяет грамматику числа JSON.
+func (s *jsonStreamScanner) number() error {
+	c, err := s.next()
+	if err != nil {
+		return err
+	}
+	if c == '-' {
+		if c, err = s.next(); err != nil {
+			return s.unexpected(err, c)
+		}
+	}
+
+	// Целая часть
+	switch {
+	case c == '0':
+		c, err = s.next()
+	case c >= '1' && c <= '9':
+		c, err = s.digits()
+	default:
+		return s.unexpected(nil, c)
+	}
+
+	// Дробная часть
+	if err == nil && c == '.' {
+		if c, err = s.next(); err != nil {
+			return s.unexpected(err, c)
+		}
+		if c < '0' || c > '9' {
+			return s.unexpected(nil, c)
+		}
+		c, err = s.digits()
+	}
+
+	// Экспонента
+	if err == nil && (c == 'e' || c == 'E') {
+		if c, err = s.next(); err != nil {
+			return s.unexpected(err, c)
+		}
+		if c == '+' || c == '-' {
+			if c, err = s.next(); err != nil {
+				return s.unexpected(err, c)
+			}
+		}
+		if c < '0' || c > '9' {
+			return s.unexpected(nil, c)
+		}
+		c, err = s.digits()
+	}
+
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	s.unread()
+	return nil
+}
+
+// digits пропускает последовательность цифр и возвращает первый байт после нее.
+func (s *jsonStreamScanner) digits() (byte, error) {
+	for {
+		c, err := s.next()
+		if err != nil || c < '0' || c > '9' {
+			return c, err
+		}
+	}
+}
+
+// literal проверяет оставшуюся часть литерала true, false или null.
+func (s *jsonStreamScanner) literal(rest string) error {
+	for i := range len(rest) {
+		c, err := s.next()
+		if err != nil || c != rest[i] {
+			return s.unexpected(err, c)
+		}
+	}
+	return nil
+}
+
+// hexDigitValue возвращает значение шестнадцатеричной цифры или -1.
+func hexDigitValue(c byte) int {
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c - '0')
+	case c >= 'a' && c <= 'f':
+		return int(c-'a') + 10
+	case c >= 'A' && c <= 'F':
+		return int(c-'A') + 10
+	}
+	return -1
+}
@@ -0,0 +1,132 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestValidateJSONStreamSyntax(t *testing.T) {
+	// Результат должен совпадать с encoding/json
+	inputs := []string{
+		`{}`, `[]`, `null`, `true`, `false`, `0`, `-0`, `12.5e-3`, `1E+2`, `"строка"`,
+		` {"a": [1, 2, {"b": null}], "c": "A\n"} `,
+		`"😀"`,
+		`[1,]`, `{"a":1,}`, `{"a" 1}`, `{a: 1}`, `[01]`, `[1.]`, `[.5]`, `[1e]`, `-`, `+1`,
+		`tru`, `nul`, `"abc`, `"\x"`, `"\u12G4"`, "\"a\tb\"", `[`, `{"a":`, `}`, ``, `   `,
+		`[1 2]`, `{"a":1 "b":2}`, `[true false]`,
+	}
+	for _, input := range inputs {
+		want := json.Valid([]byte(input))
+		err := ValidateJSONStream(strings.NewReader(input), JSONLimits{})
+		if got := err == nil; got != want {
+			t.Errorf("ValidateJSONStream(%q) error = %v, json.Valid = %v", input, err, want)
+		}
+		if err != nil && !errors.Is(err, ErrJSONSyntax) {
+			t.Errorf("ValidateJSONStream(%q) error = %v, want %v", input, err, ErrJSONSyntax)
+		}
+	}
+}
+
+func TestValidateJSONStreamLimits(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		limits     JSONLimits
+		wantErr    error
+		wantOffset int64
+	}{
+		{"Глубина в пределах", `[[[]]]`, JSONLimits{MaxDepth: 3}, nil, 0},
+		{"Превышена глубина", `[[{"a":[1]}]]`, JSONLimits{MaxDepth: 3}, ErrJSONTooDeep, 7},
+		{"Размер в пределах", `[1, 2]`, JSONLimits{MaxSize: 6}, nil, 0},
+		{"Превышен размер", `[1, 2, 3]`, JSONLimits{MaxSize: 6}, ErrJSONTooLarge, 6},
+		{"Длина строки в символах", `["Привет"]`, JSONLimits{MaxStringLength: 6}, nil, 0},
+		{"Превышена длина строки", `{"k": "Привет!"}`, JSONLimits{MaxStringLength: 6}, ErrJSONStringTooLong, 6},
+		{"Превышена длина ключа", `{"long_key": 1}`, JSONLimits{MaxStringLength: 3}, ErrJSONStringTooLong, 1},
+		{"Escape считается одним символом", `"A\n😀"`, JSONLimits{MaxStringLength: 3}, nil, 0},
+		{"Повторяющийся ключ", `{"a": 1, "b": {"a": 2}, "a": 3}`, JSONLimits{RejectDuplicateKeys: true}, ErrJSONDuplicateKey, 24},
+		{"Повторяющийся ключ через escape", `{"a": 1, "\u0061": 2}`, JSONLimits{RejectDuplicateKeys: true}, ErrJSONDuplicateKey, 9},
+		{"Повторяющиеся ключи разрешены", `{"a": 1, "a": 2}`, JSONLimits{}, nil, 0},
+		{"Одинаковые ключи в разных объектах", `[{"a": 1}, {"a": 2}]`, JSONLimits{RejectDuplicateKeys: true}, nil, 0},
+		{"Лишние данные", `{"a": 1} {"b": 2}`, JSONLimits{}, ErrJSONTrailingData, 9},
+		{"Лишние данные после числа", `123abc`, JSONLimits{}, ErrJSONTrailingData, 3},
+		{"Пробелы после значения", "[1]\n\t ", JSONLimits{}, nil, 0},
+		{"Синтаксическая ошибка", `{"a": tru}`, JSONLimits{}, ErrJSONSyntax, 9},
+		{"Неожиданный конец", `{"a": [1, 2`, JSONLimits{}, ErrJSONSyntax, 11},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateJSONStream(strings.NewReader(tt.input), tt.limits)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ValidateJSONStream() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil {
+				return
+			}
+			var streamErr *JSONStreamError
+			if !errors.As(err, &streamErr) {
+				t.Fatalf("Ожидалась ошибка типа *JSONStreamError, получено %T", err)
+			}
+			if streamErr.Offset != tt.wantOffset {
+				t.Errorf("Offset = %d, want %d (%v)", streamErr.Offset, tt.wantOffset, err)
+			}
+		})
+	}
+}
+
+func TestValidateJSONStreamDeepNesting(t *testing.T) {
+	// Глубокая вложенность не должна приводить к рекурсии
+	deep := strings.Repeat("[", 100000) + strings.Repeat("]", 100000)
+	if err := ValidateJSONStream(strings.NewReader(deep), JSONLimits{}); err != nil {
+		t.Errorf("ValidateJSONStream() error = %v", err)
+	}
+	if err := ValidateJSONStream(strings.NewReader(deep), DefaultJSONLimits); !errors.Is(err, ErrJSONTooDeep) {
+		t.Errorf("ValidateJSONStream() error = %v, want %v", err, ErrJSONTooDeep)
+	}
+}
+
+// failingReader возвращает ошибку после выдачи данных.
+type failingReader struct {
+	data string
+}
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	if r.data == "" {
+		return 0, io.ErrUnexpectedEOF
+	}
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+func TestValidateJSONStreamReaderError(t *testing.T) {
+	err := ValidateJSONStream(&failingReader{data: `{"a": [1, `}, JSONLimits{})
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("ValidateJSONStream() error = %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+}
+
+func TestIsJSONWithLimits(t *testing.T) {
+	ok, err := IsJSONWithLimits(`{"a": [1, 2, 3]}`, DefaultJSONLimits)
+	if !ok || err != nil {
+		t.Errorf("IsJSONWithLimits() = %v, %v, want true, nil", ok, err)
+	}
+	ok, err = IsJSONWithLimits(`{"a": 1, "a": 2}`, DefaultJSONLimits)
+	if ok || err == nil {
+		t.Errorf("IsJSONWithLimits() = %v, %v, want false, error", ok, err)
+	}
+	if msg := err.Error(); !strings.Contains(msg, "смещение 9") || !strings.Contains(msg, `"a"`) {
+		t.Errorf("Error() = %q", msg)
+	}
+	e := &JSONStreamError{Offset: 3, Err: ErrJSONTrailingData}
+	if e.Error() != ErrJSONTrailingData.Error()+" (смещение 3)" {
+		t.Errorf("Error() = %q", e.Error())
+	}
+}
@@ -0,0 +1,483 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"math"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// layoutPairs — символы, расположенные на одних и тех же клавишах раскладок QWERTY и ЙЦУКЕН.
+var layoutPairs = [][2]string{
+	{"`qwertyuiop[]asdfghjkl;'zxcvbnm,./", "ёйцукенгшщзхъфывапролджэячсмитьбю."},
+	{"~QWERTYUIOP{}ASDFGHJKL:\"ZXCVBNM<>?", "ЁЙЦУКЕНГШЩЗХЪФЫВАПРОЛДЖЭЯЧСМИТЬБЮ,"},
+	{"@#$^&", "\"№;:?"},
+}
+
+var (
+	// layoutEnToRu и layoutRuToEn — таблицы преобразования символов между раскладками.
+	layoutEnToRu, layoutRuToEn = buildLayoutTables()
+
+	// layoutTokenPattern — слово вместе с примыкающими знаками препинания.
+	layoutTokenPattern = regexp.MustCompile(`\S+`)
+
+	// layoutSkipPattern — слова, которые не исправляются: адреса, числа, упоминания.
+	layoutSkipPattern = regexp.MustCompile(`[0-9@/\\_]|://|^#`)
+)
+
+// buildLayoutTables строит взаимно обратные таблицы соответствия раскладок.
+func buildLayoutTables() (map[rune]rune, map[rune]rune) {
+	enToRu := make(map[rune]rune)
+	ruToEn := make(map[rune]rune)
+	for _, pair := range layoutPairs {
+		en, ru := []rune(pair[0]), []rune(pair[1])
+		for i := range en {
+			enToRu[en[i]] = ru[i]
+			ruToEn[ru[i]] = en[i]
+		}
+	}
+	return enToRu, ruToEn
+}
+
+// ConvertLayoutToRu преобразует текст, набранный в английской раскладке вместо русской:
+// "ghbdtn" → "привет". Символы вне раскладки не изменяются.
+func ConvertLayoutToRu(s string) string {
+	return convertLayout(s, layoutEnToRu)
+}
+
+// ConvertLayoutToEn преобразует текст, набранный в русской раскладке вместо английской:
+// "руддщ" → "hello". Символы вне раскладки не изменяются.
+func ConvertLayoutToEn(s string) string {
+	return convertLayout(s, layoutRuToEn)
+}
+
+// convertLayout заменяет символы строки по таблице.
+func convertLayout(s string, table map[rune]rune) string {
+	return strings.Map(func(r rune) rune {
+		if converted, ok := table[r]; ok {
+			return converted
+		}
+		return r
+	}, s)
+}
+
+// Условные вероятности букв (в процентах): "ab" — вероятность того, что за буквой a следует b.
+// Пробел обозначает границу слова: " a" — слово начинается с a, "a " — слово заканчивается на a.
+// Таблицы построены по моделям биграмм и отдельных букв библиотеки Lingua (github.com/pemistahl/lingua-go
+// v1.4.0), обученным на миллионе предложений новостных корпусов Wortschatz Лейпцигского университета;
+// в них вошли вероятности от 0,01 %. Остальные пары получают вероятность layoutBigramFloor.
+var (
+	layoutBigramsEn = map[string]float64{
+		" a": 11.53, " b": 4.62, " c": 5.44, " d": 3.08, " e": 2.29, " f": 4.37, " g": 1.99, " h": 4.36,
+		" i": 6.43, " j": 0.77, " k": 0.64, " l": 2.57, " m": 3.94, " n": 2.1, " o": 6.01, " p": 4.3,
+		" q": 0.19, " r": 2.96, " s": 8.15, " t": 15.2, " u": 1.11, " v": 0.83, " w": 5.92, " x": 0.03,
+		" y": 1.12, " z": 0.06,
+		"a ": 8.65, "aa": 0.08, "ab": 1.88, "ac": 4.08, "ad": 3.57, "ae": 0.14, "af": 1, "ag": 1.93,
+		"ah": 0.26, "ai": 3.96, "aj": 0.14, "ak": 1.41, "al": 9.65, "am": 3.36, "an": 18.09, "ao": 0.04,
+		"ap": 1.52, "aq": 0.05, "ar": 10.99, "as": 8.24, "at": 12.97, "au": 1.27, "av": 2.18, "aw": 0.7,
+		"ax": 0.18, "ay": 3.48, "az": 0.2,
+		"b ": 2.38, "ba": 11.51, "bb": 0.83, "bc": 0.26, "bd": 0.12, "be": 29.19, "bf": 0.02, "bg": 0.01,
+		"bh": 0.11, "bi": 6.13, "bj": 0.31, "bk": 0.01, "bl": 9.76, "bm": 0.2, "bn": 0.06, "bo": 11.61,
+		"bp": 0.05, "bq": 0.01, "br": 6.5, "bs": 1.79, "bt": 0.43, "bu": 11.53, "bv": 0.14, "bw": 0.09,
+		"by": 6.94, "bz": 0.01,
+		"c ": 3.57, "ca": 13.41, "cb": 0.05, "cc": 1.94, "cd": 0.08, "ce": 14.89, "cf": 0.03, "cg": 0.04,
+		"ch": 13.75, "ci": 6.38, "cj": 0.01, "ck": 4.74, "cl": 4.14, "cm": 0.07, "cn": 0.06, "co": 19.78,
+		"cp": 0.05, "cq": 0.08, "cr": 3.74, "cs": 0.5, "ct": 8.47, "cu": 3.29, "cv": 0.01, "cw": 0.01,
+		"cy": 0.88, "cz": 0.02,
+		"d ": 52.76, "da": 5.57, "db": 0.08, "dc": 0.14, "dd": 1.14, "de": 14.67, "df": 0.11, "dg": 0.65,
+		"dh": 0.11, "di": 8.89, "dj": 0.07, "dk": 0.01, "dl": 0.71, "dm": 0.37, "dn": 0.64, "do": 4.82,
+		"dp": 0.07, "dq": 0.02, "dr": 2.21, "ds": 2.66, "dt": 0.07, "du": 2.56, "dv": 0.39, "dw": 0.21,
+		"dy": 1.03, "dz": 0.02,
+		"e ": 33.22, "ea": 5.26, "eb": 0.35, "ec": 2.83, "ed": 7.18, "ee": 2.87, "ef": 0.87, "eg": 0.8,
+		"eh": 0.2, "ei": 1.17, "ej": 0.02, "ek": 0.3, "el": 3.3, "em": 2.11, "en": 8.83, "eo": 0.59,
+		"ep": 1.09, "eq": 0.18, "er": 12.97, "es": 7.96, "et": 2.76, "eu": 0.16, "ev": 1.61, "ew": 0.99,
+		"ex": 1.08, "ey": 1.23, "ez": 0.06,
+		"f ": 29.74, "fa": 6.17, "fb": 0.05, "fc": 0.07, "fd": 0.03, "fe": 8.47, "ff": 6.17, "fg": 0.08,
+		"fh": 0.01, "fi": 11.16, "fk": 0.01, "fl": 2.12, "fm": 0.04, "fn": 0.02, "fo": 20.02, "fp": 0.04,
+		"fr": 8.25, "fs": 0.24, "ft": 3.37, "fu": 3.6, "fw": 0.03, "fy": 0.29,
+		"g ": 36.85, "ga": 7.11, "gb": 0.13, "gc": 0.03, "gd": 0.09, "ge": 14.61, "gf": 0.06, "gg": 1.06,
+		"gh": 9.11, "gi": 5.3, "gj": 0.01, "gk": 0.02, "gl": 1.89, "gm": 0.38, "gn": 2.11, "go": 7,
+		"gp": 0.04, "gr": 7.39, "gs": 2.31, "gt": 0.65, "gu": 3, "gv": 0.01, "gw": 0.08, "gy": 0.75,
+		"gz": 0.01,
+		"h ": 11.28, "ha": 16.08, "hb": 0.15, "hc": 0.05, "hd": 0.1, "he": 44.89, "hf": 0.03, "hg": 0.01,
+		"hh": 0.01, "hi": 11.17, "hk": 0.01, "hl": 0.3, "hm": 0.19, "hn": 0.4, "ho": 9.33, "hp": 0.03,
+		"hq": 0.01, "hr": 1.53, "hs": 0.3, "ht": 2.14, "hu": 1.3, "hv": 0.02, "hw": 0.14, "hy": 0.54,
+		"i ": 2.59, "ia": 2.8, "ib": 0.75, "ic": 6.55, "id": 4.62, "ie": 3.78, "if": 1.76, "ig": 2.86,
+		"ih": 0.03, "ii": 0.06, "ij": 0.05, "ik": 0.74, "il": 6.13, "im": 2.83, "in": 26.85, "io": 6.67,
+		"ip": 0.93, "iq": 0.09, "ir": 3.49, "is": 10.85, "it": 11.71, "iu": 0.11, "iv": 2.87, "iw": 0.02,
+		"ix": 0.23, "iy": 0.02, "iz": 0.62,
+		"j ": 1.91, "ja": 15.33, "jb": 0.06, "jc": 0.22, "jd": 0.09, "je": 15.91, "jf": 0.06, "jg": 0.02,
+		"jh": 0.12, "ji": 2.73, "jj": 0.09, "jk": 0.07, "jl": 0.05, "jm": 0.07, "jn": 0.07, "jo": 28.47,
+		"jp": 0.27, "jr": 0.62, "js": 0.12, "jt": 0.07, "ju": 33.45, "jv": 0.09, "jw": 0.07, "jy": 0.03,
+		"jz": 0.01,
+		"k ": 30.11, "ka": 3.96, "kb": 0.14, "kc": 0.07, "kd": 0.15, "ke": 31.07, "kf": 0.35, "kg": 0.2,
+		"kh": 0.62, "ki": 14.86, "kj": 0.01, "kk": 0.13, "kl": 1.75, "km": 0.23, "kn": 4.58, "ko": 1.63,
+		"kp": 0.21, "kr": 0.59, "ks": 6.83, "kt": 0.22, "ku": 0.71, "kv": 0.06, "kw": 0.31, "ky": 1.19,
+		"kz": 0.01,
+		"l ": 17.55, "la": 10, "lb": 0.23, "lc": 0.24, "ld": 5.21, "le": 15.82, "lf": 0.74, "lg": 0.1,
+		"lh": 0.05, "li": 11.9, "lj": 0.01, "lk": 0.59, "ll": 13.56, "lm": 0.54, "ln": 0.1, "lo": 7.56,
+		"lp": 0.64, "lr": 0.22, "ls": 3.2, "lt": 2.05, "lu": 2.17, "lv": 0.54, "lw": 0.27, "ly": 6.69,
+		"lz": 0.02,
+		"m ": 14.21, "ma": 16.9, "mb": 3.18, "mc": 0.44, "md": 0.05, "me": 24.89, "mf": 0.15, "mg": 0.02,
+		"mh": 0.04, "mi": 10.39, "mj": 0.01, "mk": 0.02, "ml": 0.12, "mm": 3.52, "mn": 0.23, "mo": 10.79,
+		"mp": 6.49, "mr": 0.41, "ms": 2.37, "mt": 0.25, "mu": 3.53, "mv": 0.02, "mw": 0.05, "my": 1.92,
+		"mz": 0.01,
+		"n ": 26.39, "na": 3.77, "nb": 0.09, "nc": 3.81, "nd": 14.09, "ne": 8.12, "nf": 0.63, "ng": 12.46,
+		"nh": 0.1, "ni": 4.11, "nj": 0.2, "nk": 0.81, "nl": 0.58, "nm": 0.37, "nn": 1.34, "no": 4.43,
+		"np": 0.07, "nq": 0.03, "nr": 0.09, "ns": 4.68, "nt": 11.02, "nu": 0.91, "nv": 0.59, "nw": 0.09,
+		"nx": 0.02, "ny": 1.14, "nz": 0.05,
+		"o ": 13.12, "oa": 0.93, "ob": 0.91, "oc": 1.67, "od": 1.63, "oe": 0.42, "of": 8.02, "og": 0.84,
+		"oh": 0.25, "oi": 1.05, "oj": 0.17, "ok": 0.92, "ol": 3.83, "om": 5.97, "on": 16.83, "oo": 3.04,
+		"op": 2.73, "oq": 0.01, "or": 13.5, "os": 2.92, "ot": 4.22, "ou": 10.38, "ov": 2.3, "ow": 3.69,
+		"ox": 0.15, "oy": 0.45, "oz": 0.06,
+		"p ": 8.87, "pa": 12.65, "pb": 0.1, "pc": 0.14, "pd": 0.21, "pe": 17.19, "pf": 0.08, "pg": 0.09,
+		"ph": 2.42, "pi": 4.84, "pj": 0.01, "pk": 0.06, "pl": 10.54, "pm": 0.83, "pn": 0.06, "po": 12.73,
+		"pp": 5.18, "pr": 15.17, "ps": 1.87, "pt": 2.64, "pu": 3.88, "pv": 0.03, "pw": 0.03, "py": 0.38,
+		"q ": 4.15, "qa": 1.48, "qb": 0.27, "qc": 0.08, "qd": 0.02, "qe": 0.08, "qf": 0.06, "qh": 0.03,
+		"qi": 0.89, "qj": 0.01, "ql": 0.07, "qm": 0.11, "qn": 0.02, "qo": 0.07, "qp": 0.04, "qq": 0.07,
+		"qr": 0.07, "qs": 0.16, "qt": 0.06, "qu": 92.14, "qv": 0.05, "qw": 0.04, "qx": 0.01, "qy": 0.01,
+		"qz": 0.01,
+		"r ": 21.04, "ra": 7.71, "rb": 0.33, "rc": 1.42, "rd": 2.65, "re": 22.13, "rf": 0.37, "rg": 1.23,
+		"rh": 0.16, "ri": 8.68, "rj": 0.01, "rk": 1.63, "rl": 1.06, "rm": 1.65, "rn": 2.22, "ro": 9.05,
+		"rp": 0.37, "rq": 0.01, "rr": 1.45, "rs": 6, "rt": 4.98, "ru": 1.72, "rv": 0.92, "rw": 0.2,
+		"ry": 2.99, "rz": 0.02,
+		"s ": 42.54, "sa": 4.21, "sb": 0.16, "sc": 1.8, "sd": 0.37, "se": 9.74, "sf": 0.15, "sg": 0.05,
+		"sh": 4.16, "si": 6.01, "sj": 0.01, "sk": 0.62, "sl": 0.64, "sm": 0.6, "sn": 0.35, "so": 4.72,
+		"sp": 2.26, "sq": 0.11, "sr": 0.13, "ss": 4.17, "st": 13.42, "su": 3, "sv": 0.05, "sw": 0.3,
+		"sy": 0.43, "sz": 0.01,
+		"t ": 22.93, "ta": 4.54, "tb": 0.11, "tc": 0.37, "td": 0.04, "te": 10.1, "tf": 0.07, "tg": 0.02,
+		"th": 27.76, "ti": 9.29, "tk": 0.01, "tl": 0.75, "tm": 0.3, "tn": 0.13, "to": 10.55, "tp": 0.03,
+		"tr": 3.4, "ts": 3.18, "tt": 1.6, "tu": 1.95, "tv": 0.04, "tw": 0.66, "ty": 2.08, "tz": 0.05,
+		"u ": 4.27, "ua": 3.2, "ub": 2.4, "uc": 4.1, "ud": 3.13, "ue": 4.07, "uf": 0.45, "ug": 3.78,
+		"uh": 0.06, "ui": 2.69, "uj": 0.03, "uk": 0.27, "ul": 8.35, "um": 3.37, "un": 13.73, "uo": 0.17,
+		"up": 4.57, "uq": 0.02, "ur": 15.69, "us": 12.46, "ut": 12.4, "uu": 0.02, "uv": 0.13, "uw": 0.03,
+		"ux": 0.09, "uy": 0.4, "uz": 0.12,
+		"v ": 1.68, "va": 8.08, "vb": 0.01, "vc": 0.07, "vd": 0.06, "ve": 63.05, "vf": 0.02, "vg": 0.01,
+		"vh": 0.02, "vi": 21.01, "vl": 0.05, "vm": 0.02, "vn": 0.02, "vo": 4.96, "vp": 0.06, "vr": 0.09,
+		"vs": 0.16, "vt": 0.04, "vu": 0.17, "vv": 0.03, "vw": 0.01, "vy": 0.37,
+		"w ": 10.67, "wa": 17.97, "wb": 0.1, "wc": 0.13, "wd": 0.23, "we": 17.64, "wf": 0.08, "wg": 0.01,
+		"wh": 13.97, "wi": 19.48, "wj": 0.01, "wk": 0.11, "wl": 0.52, "wm": 0.11, "wn": 4.21, "wo": 10.74,
+		"wp": 0.05, "wr": 1.13, "ws": 2.08, "wt": 0.26, "wu": 0.04, "wv": 0.01, "ww": 0.25, "wy": 0.21,
+		"x ": 20.72, "xa": 7.25, "xb": 0.15, "xc": 7.68, "xd": 0.02, "xe": 7.31, "xf": 0.29, "xg": 0.01,
+		"xh": 1.54, "xi": 9.12, "xj": 0.01, "xk": 0.01, "xl": 0.24, "xm": 0.08, "xn": 0.06, "xo": 0.77,
+		"xp": 25.34, "xq": 0.06, "xr": 0.02, "xs": 0.12, "xt": 16.3, "xu": 1.97, "xv": 0.14, "xw": 0.11,
+		"xx": 0.18, "xy": 0.48,
+		"y ": 70.88, "ya": 1.33, "yb": 0.43, "yc": 0.42, "yd": 0.27, "ye": 7.03, "yf": 0.07, "yg": 0.07,
+		"yh": 0.04, "yi": 1.64, "yk": 0.04, "yl": 0.83, "ym": 0.75, "yn": 0.57, "yo": 9.12, "yp": 0.47,
+		"yr": 0.36, "ys": 4.42, "yt": 0.76, "yu": 0.14, "yv": 0.04, "yw": 0.26, "yy": 0.01, "yz": 0.04,
+		"z ": 12.51, "za": 17.57, "zb": 0.41, "zc": 0.14, "zd": 0.18, "ze": 36.92, "zf": 0.06, "zg": 0.23,
+		"zh": 1.01, "zi": 12.36, "zj": 0.01, "zk": 0.28, "zl": 1.25, "zm": 0.41, "zn": 0.2, "zo": 7.44,
+		"zp": 0.18, "zq": 0.04, "zr": 0.16, "zs": 0.16, "zt": 0.17, "zu": 1.65, "zv": 0.16, "zw": 0.15,
+		"zx": 0.01, "zy": 1.87, "zz": 4.47,
+	}
+	layoutBigramsRu = map[string]float64{
+		" а": 2.31, " б": 3.25, " в": 9.91, " г": 2.91, " д": 4.54, " е": 1.33, " ж": 0.7, " з": 2.69,
+		" и": 5.66, " й": 0.04, " к": 5.44, " л": 1.4, " м": 4.37, " н": 7.89, " о": 6.42, " п": 12.48,
+		" р": 4.59, " с": 10.23, " т": 3.83, " у": 2.61, " ф": 1, " х": 0.59, " ц": 0.45, " ч": 2.51,
+		" ш": 0.41, " щ": 0.01, " э": 1.65, " ю": 0.2, " я": 0.54,
+		"а ": 21.89, "аа": 0.03, "аб": 1.74, "ав": 5.56, "аг": 1.07, "ад": 2.68, "ае": 2.41, "аж": 1.16,
+		"аз": 3.94, "аи": 0.8, "ай": 1.09, "ак": 5.02, "ал": 8.34, "ам": 4.35, "ан": 10.74, "ао": 0.1,
+		"ап": 1.31, "ар": 5.15, "ас": 5.2, "ат": 7.25, "ау": 0.25, "аф": 0.34, "ах": 1.47, "ац": 1.65,
+		"ач": 1.33, "аш": 0.76, "ащ": 0.46, "аэ": 0.1, "аю": 1.31, "ая": 2.47, "аё": 0.01,
+		"б ": 2.69, "ба": 8.17, "бб": 0.31, "бв": 0.57, "бг": 0.02, "бд": 0.17, "бе": 10.07, "бж": 0.17,
+		"бз": 0.06, "би": 6.86, "бк": 0.52, "бл": 8.8, "бм": 0.28, "бн": 2.33, "бо": 18.25, "бп": 0.02,
+		"бр": 8.22, "бс": 1.89, "бт": 0.03, "бу": 7.87, "бф": 0.01, "бх": 0.9, "бц": 0.02, "бч": 0.03,
+		"бш": 0.23, "бщ": 5.13, "бъ": 1.91, "бы": 12.17, "бь": 0.1, "бэ": 0.05, "бю": 0.82, "бя": 1.32,
+		"бё": 0.01,
+		"в ": 24.26, "ва": 14.17, "вб": 0.04, "вв": 0.22, "вг": 0.3, "вд": 0.38, "ве": 10.74, "вз": 0.51,
+		"ви": 8.03, "вк": 1.09, "вл": 4.02, "вм": 0.39, "вн": 3.33, "во": 14.49, "вп": 0.35, "вр": 2.3,
+		"вс": 3.6, "вт": 1.21, "ву": 1.61, "вф": 0.02, "вх": 0.11, "вц": 0.08, "вч": 0.11, "вш": 1.07,
+		"вщ": 0.08, "въ": 0.02, "вы": 6.33, "вь": 0.32, "вэ": 0.02, "вя": 0.79, "вё": 0.01,
+		"г ": 3.71, "га": 10.18, "гб": 0.11, "гв": 0.09, "гг": 0.12, "гд": 2.7, "ге": 5.07, "гз": 0.01,
+		"ги": 9.05, "гк": 0.37, "гл": 5.52, "гм": 0.17, "гн": 1.07, "го": 48.22, "гп": 0.03, "гр": 9.38,
+		"гс": 0.21, "гт": 0.17, "гу": 3.52, "гх": 0.01, "гц": 0.04, "гч": 0.08, "гш": 0.01, "гы": 0.03,
+		"гэ": 0.1, "гю": 0.02,
+		"д ": 4.37, "да": 15.99, "дб": 0.06, "дв": 2.56, "дг": 0.23, "дд": 0.57, "де": 20.54, "дж": 1.03,
+		"дз": 0.21, "ди": 9.65, "дк": 0.9, "дл": 3.51, "дм": 0.8, "дн": 7.03, "до": 13.87, "дп": 1.1,
+		"др": 2.81, "дс": 3.39, "дт": 0.36, "ду": 6.15, "дф": 0.02, "дх": 0.1, "дц": 0.2, "дч": 0.36,
+		"дш": 0.26, "дъ": 0.11, "ды": 2.03, "дь": 0.77, "дэ": 0.06, "дю": 0.09, "дя": 0.83, "дё": 0.05,
+		"е ": 19.54, "еа": 0.3, "еб": 0.81, "ев": 2.63, "ег": 2.93, "ед": 5.76, "ее": 1.44, "еж": 1.07,
+		"ез": 1.87, "еи": 0.16, "ей": 3.32, "ек": 2.83, "ел": 7.06, "ем": 4.82, "ен": 14.48, "ео": 0.49,
+		"еп": 0.83, "ер": 9.62, "ес": 6.77, "ет": 8.72, "еу": 0.08, "еф": 0.33, "ех": 0.72, "ец": 0.5,
+		"еч": 1.16, "еш": 0.76, "ещ": 0.6, "еэ": 0.01, "ею": 0.13, "ея": 0.23, "её": 0.02,
+		"ж ": 1.38, "жа": 10.9, "жб": 2.22, "жв": 0.04, "жг": 0.09, "жд": 12.68, "же": 35.92, "жж": 0.08,
+		"жи": 15.11, "жк": 1.83, "жл": 0.04, "жм": 0.11, "жн": 14.5, "жо": 0.86, "жп": 0.06, "жр": 0.13,
+		"жс": 0.44, "жт": 0.01, "жу": 2.48, "жц": 0.05, "жч": 0.53, "жы": 0.01, "жь": 0.33, "жэ": 0.05,
+		"жю": 0.09, "жё": 0.07,
+		"з ": 8.8, "за": 34.13, "зб": 1.66, "зв": 6.24, "зг": 0.96, "зд": 5.23, "зе": 3.21, "зж": 0.3,
+		"зз": 0.04, "зи": 8.2, "зк": 0.73, "зл": 1.09, "зм": 3.65, "зн": 7.29, "зо": 7.17, "зп": 0.21,
+		"зр": 2.98, "зс": 0.33, "зт": 0.05, "зу": 2.97, "зц": 0.08, "зч": 0.14, "зш": 0.01, "зъ": 0.18,
+		"зы": 2.95, "зь": 0.3, "зэ": 0.02, "зю": 0.07, "зя": 1, "зё": 0.01,
+		"и ": 22.85, "иа": 1.45, "иб": 0.62, "ив": 2.71, "иг": 0.97, "ид": 1.92, "ие": 4.64, "иж": 0.47,
+		"из": 4.07, "ии": 4.38, "ий": 3.37, "ик": 3.94, "ил": 5.69, "им": 4.04, "ин": 7.1, "ио": 1.78,
+		"ип": 0.38, "ир": 2.94, "ис": 4.76, "ит": 7.86, "иу": 0.06, "иф": 0.22, "их": 2.54, "иц": 1.64,
+		"ич": 2.41, "иш": 0.44, "ищ": 0.17, "иэ": 0.02, "ию": 1.45, "ия": 5.12,
+		"й ": 77.1, "йа": 0.05, "йб": 0.18, "йв": 0.1, "йг": 0.08, "йд": 1.54, "йе": 0.14, "йз": 0.09,
+		"йи": 0.02, "йк": 0.86, "йл": 0.35, "йм": 0.56, "йн": 2.6, "йо": 1.49, "йп": 0.05, "йр": 0.11,
+		"йс": 8.95, "йт": 2.4, "йф": 0.07, "йх": 0.04, "йц": 0.47, "йч": 1.3, "йш": 1.34, "йщ": 0.05,
+		"йэ": 0.01, "йя": 0.04,
+		"к ": 9.95, "ка": 18.04, "кб": 0.04, "кв": 1.75, "кг": 0.05, "кд": 0.02, "ке": 2.53, "кж": 1.04,
+		"кз": 0.08, "ки": 11.92, "кк": 0.18, "кл": 2.38, "км": 0.08, "кн": 0.67, "ко": 31.04, "кп": 0.11,
+		"кр": 6.04, "кс": 2.45, "кт": 5.33, "ку": 5.02, "кф": 0.02, "кх": 0.05, "кц": 1.07, "кч": 0.01,
+		"кш": 0.04, "кы": 0.02, "кь": 0.01, "кэ": 0.04, "кю": 0.01, "кя": 0.01,
+		"л ": 8.1, "ла": 13.19, "лб": 0.04, "лв": 0.02, "лг": 0.31, "лд": 0.21, "ле": 15.33, "лж": 1.01,
+		"лз": 0.02, "ли": 17.93, "лк": 0.74, "лл": 1.63, "лм": 0.04, "лн": 1.23, "ло": 11.62, "лп": 0.05,
+		"лр": 0.13, "лс": 1.1, "лт": 0.22, "лу": 3.46, "лф": 0.01, "лх": 0.01, "лч": 0.05, "лш": 0.01,
+		"лщ": 0.01, "лы": 0.77, "ль": 14.72, "лэ": 0.03, "лю": 2.17, "ля": 5.8, "лё": 0.04,
+		"м ": 25.79, "ма": 10.2, "мб": 0.35, "мв": 0.27, "мг": 0.08, "мд": 0.01, "ме": 16.51, "мж": 0.01,
+		"мз": 0.03, "ми": 13.23, "мк": 0.51, "мл": 0.95, "мм": 1.84, "мн": 2.48, "мо": 12.71, "мп": 2.67,
+		"мр": 0.08, "мс": 0.85, "мт": 0.05, "му": 4.81, "мф": 0.13, "мх": 0.01, "мц": 0.07, "мч": 0.19,
+		"мш": 0.01, "мщ": 0.02, "мы": 3.63, "мь": 0.66, "мэ": 0.29, "мю": 0.03, "мя": 1.51, "мё": 0.01,
+		"н ": 4.23, "на": 17.03, "нб": 0.12, "нв": 0.3, "нг": 0.57, "нд": 1.62, "не": 10.92, "нж": 0.04,
+		"нз": 0.13, "ни": 17.57, "нк": 1.65, "нл": 0.04, "нм": 0.01, "нн": 5.34, "но": 17.83, "нп": 0.06,
+		"нр": 0.07, "нс": 2.47, "нт": 4.09, "ну": 2.21, "нф": 0.52, "нх": 0.04, "нц": 0.96, "нч": 0.23,
+		"нш": 0.05, "нщ": 0.1, "нъ": 0.01, "ны": 8.98, "нь": 1.03, "нэ": 0.02, "ню": 0.12, "ня": 1.64,
+		"нё": 0.02,
+		"о ": 16.11, "оа": 0.09, "об": 4.82, "ов": 10.84, "ог": 4.93, "од": 6.34, "ое": 1.75, "ож": 1.64,
+		"оз": 1.54, "ои": 0.97, "ой": 3.89, "ок": 2.24, "ол": 5.83, "ом": 6.04, "он": 5.03, "оо": 0.91,
+		"оп": 1.92, "ор": 6.8, "ос": 8.06, "от": 5.84, "оу": 0.11, "оф": 0.33, "ох": 0.42, "оц": 0.4,
+		"оч": 1.26, "ош": 0.65, "ощ": 0.27, "оэ": 0.11, "ою": 0.19, "оя": 0.66, "оё": 0.01,
+		"п ": 0.74, "па": 7.5, "пб": 0.02, "пв": 0.01, "пг": 0.02, "пд": 0.02, "пе": 9.35, "пз": 0.01,
+		"пи": 3.93, "пк": 0.28, "пл": 3.74, "пм": 0.04, "пн": 0.58, "по": 34.53, "пп": 0.88, "пр": 32.63,
+		"пс": 0.22, "пт": 0.33, "пу": 3.05, "пф": 0.02, "пх": 0.02, "пц": 0.16, "пч": 0.03, "пш": 0.03,
+		"пы": 0.98, "пь": 0.19, "пэ": 0.02, "пю": 0.01, "пя": 0.66,
+		"р ": 2.95, "ра": 20.59, "рб": 0.41, "рв": 1.05, "рг": 1.74, "рд": 0.78, "ре": 17.54, "рж": 0.92,
+		"рз": 0.05, "ри": 10.62, "рк": 0.97, "рл": 0.3, "рм": 1.38, "рн": 2.39, "ро": 20.17, "рп": 0.27,
+		"рр": 0.51, "рс": 1.83, "рт": 2.51, "ру": 5.49, "рф": 0.46, "рх": 0.29, "рц": 0.13, "рч": 0.12,
+		"рш": 0.42, "рщ": 0.01, "ры": 3.48, "рь": 0.74, "рэ": 0.03, "рю": 0.09, "ря": 1.75, "рё": 0.02,
+		"с ": 5.82, "са": 3.53, "сб": 0.35, "св": 2.12, "сг": 0.06, "сд": 0.38, "се": 5.95, "сж": 0.02,
+		"сз": 0.01, "си": 4.93, "ск": 10.75, "сл": 4.88, "см": 1.22, "сн": 2.02, "со": 7.98, "сп": 3.75,
+		"ср": 1.07, "сс": 4.45, "ст": 27.42, "су": 2.46, "сф": 0.18, "сх": 0.28, "сц": 0.09, "сч": 0.74,
+		"сш": 0.49, "съ": 0.04, "сы": 0.61, "сь": 2.23, "сэ": 0.02, "сю": 0.1, "ся": 5.99, "сё": 0.04,
+		"т ": 12.05, "та": 12.37, "тб": 0.21, "тв": 5.96, "тг": 0.02, "тд": 0.25, "те": 10.41, "тз": 0.02,
+		"ти": 9.87, "тк": 1.13, "тл": 0.24, "тм": 0.59, "тн": 2.9, "то": 18.28, "тп": 0.17, "тр": 7.24,
+		"тс": 3.82, "тт": 0.12, "ту": 2.85, "тф": 0.07, "тх": 0.02, "тц": 0.03, "тч": 0.31, "тщ": 0.01,
+		"тъ": 0.01, "ты": 2.4, "ть": 7.74, "тэ": 0.03, "тю": 0.08, "тя": 0.78, "тё": 0.01,
+		"у ": 19.22, "уа": 1.05, "уб": 3.83, "ув": 1.66, "уг": 2.89, "уд": 8.64, "уе": 1.75, "уж": 4.86,
+		"уз": 1.53, "уи": 0.18, "уй": 0.17, "ук": 4.87, "ул": 4.06, "ум": 3.03, "ун": 2.48, "уо": 0.06,
+		"уп": 4.73, "ур": 6.4, "ус": 6.06, "ут": 6.88, "уу": 0.01, "уф": 0.15, "ух": 1.1, "уц": 0.21,
+		"уч": 5.42, "уш": 1.45, "ущ": 1.82, "уэ": 0.14, "ую": 5.17, "уя": 0.17,
+		"ф ": 6.9, "фа": 8.63, "фб": 0.12, "фв": 0.01, "фг": 1.44, "фд": 0.01, "фе": 16.85, "фз": 0.13,
+		"фи": 22.47, "фк": 0.23, "фл": 2.91, "фм": 0.15, "фн": 0.52, "фо": 21.18, "фп": 0.07, "фр": 6.09,
+		"фс": 1.35, "фт": 3.47, "фу": 3.9, "фф": 2.21, "фх": 0.03, "фц": 0.06, "фч": 0.01, "фш": 0.04,
+		"фы": 0.59, "фь": 0.32, "фэ": 0.06, "фю": 0.07, "фя": 0.16, "фё": 0.02,
+		"х ": 61.48, "ха": 5.53, "хб": 0.01, "хв": 0.86, "хг": 0.13, "хд": 0.07, "хе": 0.93, "хз": 0.02,
+		"хи": 2.53, "хк": 0.09, "хл": 0.66, "хм": 0.45, "хн": 2.4, "хо": 18.92, "хп": 0.04, "хр": 2.71,
+		"хс": 1.09, "хт": 0.48, "ху": 1.24, "хф": 0.01, "хх": 0.04, "хц": 0.01, "хч": 0.03, "хш": 0.02,
+		"хъ": 0.02, "хы": 0.01, "хь": 0.03, "хэ": 0.19, "хю": 0.01, "хё": 0.01,
+		"ц ": 4.06, "ца": 6.07, "цб": 0.32, "цв": 0.6, "цг": 0.02, "цд": 0.02, "це": 24.14, "цз": 0.11,
+		"ци": 53.57, "цк": 1.93, "цл": 0.12, "цм": 0.03, "цн": 0.09, "цо": 1.5, "цп": 0.17, "цр": 0.16,
+		"цс": 0.49, "цт": 0.04, "цу": 2.63, "цф": 0.02, "цх": 0.04, "цц": 0.08, "цш": 0.01, "цы": 3.69,
+		"ць": 0.01, "цэ": 0.02, "цю": 0.06, "ця": 0.01,
+		"ч ": 2.52, "ча": 16.71, "чб": 0.01, "чв": 0.07, "че": 32.22, "чж": 0.03, "чи": 15.17, "чк": 1.61,
+		"чл": 0.65, "чм": 0.11, "чн": 8.34, "чо": 0.15, "чп": 0.07, "чр": 0.49, "чс": 0.35, "чт": 17.5,
+		"чу": 1.57, "чф": 0.02, "чх": 0.02, "чч": 0.01, "чш": 0.92, "чь": 1.23, "чэ": 0.01, "чё": 0.2,
+		"ш ": 1.13, "ша": 11.95, "шб": 0.02, "шв": 0.98, "шг": 0.03, "шд": 0.01, "ше": 35.61, "ши": 22.88,
+		"шк": 4.17, "шл": 7.04, "шм": 0.22, "шн": 4.57, "шо": 3.14, "шп": 0.33, "шр": 0.43, "шс": 0.18,
+		"шт": 2.75, "шу": 2.19, "шф": 0.01, "шх": 0.03, "шц": 0.04, "шы": 0.01, "шь": 2.07, "шэ": 0.06,
+		"шю": 0.05, "шё": 0.1,
+		"щ ": 0.17, "ща": 16.52, "ще": 44.13, "щи": 33.13, "щн": 1.82, "що": 0.03, "щр": 0.1, "щу": 1.8,
+		"щь": 1.78, "щё": 0.5,
+		"ъ ": 1.84, "ъа": 0.01, "ъв": 0.01, "ъе": 58.72, "ъи": 0.02, "ъс": 0.01, "ъэ": 0.02, "ъю": 0.64,
+		"ъя": 37.34, "ъё": 1.36,
+		"ы ": 30.98, "ыб": 1.24, "ыв": 4.47, "ыг": 0.57, "ыд": 0.79, "ые": 9.99, "ыж": 0.15, "ыз": 0.48,
+		"ыи": 0.16, "ый": 9.49, "ык": 0.83, "ыл": 6.01, "ым": 7.86, "ын": 1.8, "ып": 1.02, "ыр": 1.38,
+		"ыс": 4.26, "ыт": 3.25, "ыу": 0.01, "ых": 12.6, "ыц": 0.01, "ыч": 0.65, "ыш": 1.64, "ыщ": 0.03,
+		"ыя": 0.31,
+		"ь ": 48.32, "ьб": 0.83, "ьв": 0.21, "ьг": 0.73, "ьд": 0.22, "ье": 3.23, "ьз": 1.81, "ьи": 0.55,
+		"ьк": 3.96, "ьм": 1.47, "ьн": 17.89, "ьо": 0.11, "ьп": 0.08, "ьр": 0.01, "ьс": 8.54, "ьт": 2.29,
+		"ьф": 0.17, "ьх": 0.09, "ьц": 0.55, "ьч": 0.21, "ьш": 3.4, "ьщ": 0.27, "ьэ": 0.01, "ью": 3.06,
+		"ья": 1.93, "ьё": 0.03,
+		"э ": 0.68, "эа": 0.02, "эб": 0.25, "эв": 0.99, "эг": 0.19, "эд": 0.61, "эе": 0.02, "эж": 0.01,
+		"эз": 0.1, "эи": 0.01, "эй": 0.74, "эк": 16.23, "эл": 5.26, "эм": 1.46, "эн": 4.38, "эо": 0.02,
+		"эп": 1.17, "эр": 5.27, "эс": 2, "эт": 57.27, "эу": 0.03, "эф": 2.55, "эх": 0.28, "эц": 0.1,
+		"эч": 0.01, "эш": 0.26, "ээ": 0.02, "эю": 0.01, "эя": 0.04,
+		"ю ": 42.46, "юа": 0.24, "юб": 2.38, "юв": 0.12, "юг": 0.65, "юд": 5.83, "юе": 0.05, "юж": 1.36,
+		"юз": 1.71, "юи": 0.04, "юй": 0.18, "юк": 0.83, "юл": 2.15, "юм": 0.56, "юн": 2.13, "юо": 0.02,
+		"юп": 0.04, "юр": 2.43, "юс": 1.56, "ют": 19.2, "юу": 0.01, "юф": 0.02, "юх": 0.08, "юц": 0.47,
+		"юч": 4.28, "юш": 0.12, "ющ": 10.63, "юэ": 0.02, "юю": 0.41,
+		"я ": 60.05, "яб": 1.57, "яв": 4.52, "яг": 0.24, "яд": 1.84, "яе": 2.55, "яж": 0.66, "яз": 2.1,
+		"яи": 0.05, "яй": 0.25, "як": 0.6, "ял": 1.87, "ям": 2.78, "ян": 3.49, "яо": 0.01, "яп": 0.22,
+		"яр": 0.7, "яс": 1.35, "ят": 8.24, "яф": 0.01, "ях": 1.48, "яц": 0.76, "яч": 1.44, "яш": 0.2,
+		"ящ": 1.38, "яю": 1.31, "яя": 0.34,
+		"ё ": 28.13, "ёб": 0.53, "ёв": 3.19, "ёг": 1.02, "ёд": 1.22, "ёе": 0.02, "ёж": 1.39, "ёз": 2.31,
+		"ёй": 0.19, "ёк": 1.09, "ёл": 4.69, "ём": 11.44, "ён": 15.04, "ёп": 0.37, "ёр": 6.78, "ёс": 1.73,
+		"ёт": 18.13, "ёу": 0.01, "ёф": 0.04, "ёх": 2.07, "ёц": 0.02, "ёч": 0.05, "ёш": 0.48, "ёщ": 0.04,
+		"ёя": 0.01,
+	}
+)
+
+const (
+	// layoutBigramFloor — вероятность биграммы, отсутствующей в таблице.
+	layoutBigramFloor = 0.005
+	// layoutMistypeThreshold — минимальный выигрыш в оценке, при котором слово считается набранным не в той раскладке.
+	layoutMistypeThreshold = 0.3
+)
+
+// layoutScore возвращает среднюю десятичную логарифмическую вероятность биграмм букв текста
+// и число учтенных биграмм. Текст разбивается на слова так же, как в FilterLetters;
+// начало и конец слова учитываются как биграммы с пробелом, однобуквенные слова пропускаются.
+func layoutScore(s string, bigrams map[string]float64) (float64, int) {
+	sum, n := 0.0, 0
+	for _, word := range filterLettersPattern.Split(strings.ToLower(s), -1) {
+		runes := []rune(" " + word + " ")
+		if len(runes) < 4 {
+			continue
+		}
+		for i := 1; i < len(runes); i++ {
+			prob, ok := bigrams[string(runes[i-1:i+1])]
+			if !ok {
+				prob = layoutBigramFloor
+			}
+			sum += math.Log10(prob)
+			n++
+		}
+	}
+	if n == 0 {
+		return 0, 0
+	}
+	return sum / float64(n), n
+}
+
+// isCyrillicText сообщает, преобладают ли в строке буквы кириллицы.
+func isCyrillicText(s string) bool {
+	latin, cyrillic := 0, 0
+	for _, r := range s {
+		switch {
+		case unicode.Is(unicode.Cyrillic, r):
+			cyrillic++
+		case unicode.Is(unicode.Latin, r):
+			latin++
+		}
+	}
+	return cyrillic > latin
+}
+
+// LayoutMistypeScore оценивает, насколько вероятнее текст после смены раскладки, чем исходный:
+// разница средних логарифмических вероятностей буквенных биграмм. Значения больше 0,3 означают,
+// что текст, скорее всего, набран не в той раскладке; 0 — оценить текст не удалось.
+func LayoutMistypeScore(s string) float64 {
+	score, _ := layoutMistype(s)
+	return score
+}
+
+// layoutMistype возвращает оценку LayoutMistypeScore и число биграмм текста после смены раскладки.
+func layoutMistype(s string) (float64, int) {
+	source, target, converted := layoutBigramsEn, layoutBigramsRu, ConvertLayoutToRu(s)
+	if isCyrillicText(s) {
+		source, target, converted = layoutBigramsRu, layoutBigramsEn, ConvertLayoutToEn(s)
+	}
+	original, n := layoutScore(s, source)
+	fixed, m := layoutScore(converted, target)
+	if m == 0 {
+		return 0, 0
+	}
+	if n == 0 {
+		// В исходном тексте нет ни одной пары букв: "k.,k." → "люблю"
+		original = math.Log10(layoutBigramFloor)
+	}
+	return fixed - original, m
+}
+
+// FixLayout исправляет слова, набранные не в той раскладке, и сообщает, были ли исправления:
+// FixLayout("ghbdtn, мир") → "привет, мир", true. Каждое слово проверяется отдельно
+// по частотам буквенных биграмм; слова короче трех букв, числа и адреса не изменяются.
+func FixLayout(s string) (string, bool) {
+	changed := false
+	fixed := layoutTokenPattern.ReplaceAllStringFunc(s, func(token string) string {
+		if layoutSkipPattern.MatchString(token) {
+			return token
+		}
+		if word, ok := fixLayoutWord(token); ok {
+			changed = true
+			return word
+		}
+		return token
+	})
+	return fixed, changed
+}
+
+// layoutLetterKeys — знаки английской раскладки, находящиеся на месте русских букв.
+const layoutLetterKeys = "`[];',.~{}:\"<>"
+
+// fixLayoutWord исправляет раскладку одного слова. Знаки препинания по краям слова сохраняются,
+// но для английской раскладки пробуется и вариант, в котором они являются буквами: "[jxe" → "хочу".
+func fixLayoutWord(token string) (string, bool) {
+	start := strings.IndexFunc(token, unicode.IsLetter)
+	if start < 0 {
+		return token, false
+	}
+	end := strings.LastIndexFunc(token, unicode.IsLetter)
+	_, size := utf8.DecodeRuneInString(token[end:])
+	end += size
+
+	core := token[start:end]
+	if isCyrillicText(core) {
+		if StringLength(FilterLetters(core)) < 3 {
+			return token, false
+		}
+		// Английское слово не содержит знаков внутри: "c]tim" — не слово
+		converted := ConvertLayoutToEn(core)
+		inner := strings.TrimFunc(converted, func(r rune) bool { return !unicode.IsLetter(r) })
+		if strings.IndexFunc(inner, func(r rune) bool { return !unicode.IsLetter(r) && r != '\'' && r != '-' }) >= 0 {
+			return token, false
+		}
+		if LayoutMistypeScore(core) < layoutMistypeThreshold {
+			return token, false
+		}
+		return token[:start] + converted + token[end:], true
+	}
+
+	// Варианты границ слова: с примыкающими знаками на месте русских букв и без них
+	isLetterKey := func(r rune) bool { return strings.ContainsRune(layoutLetterKeys, r) }
+	head := len(strings.TrimRightFunc(token[:start], isLetterKey))
+	tail := len(token) - len(strings.TrimLeftFunc(token[end:], isLetterKey))
+	if StringLength(FilterLetters(ConvertLayoutToRu(token[head:tail]))) < 3 {
+		return token, false
+	}
+
+	// Из подходящих вариантов выбирается самый вероятный в целом, а не в среднем на биграмму:
+	// иначе "[jxe" превращалось бы в "[очу", а не в "хочу"
+	bestStart, bestEnd, bestGain := -1, -1, 0.0
+	for _, from := range []int{start, head} {
+		for _, to := range []int{end, tail} {
+			candidate := token[from:to]
+			if !isRussianWordShape(ConvertLayoutToRu(candidate)) {
+				continue
+			}
+			score, n := layoutMistype(candidate)
+			if score < layoutMistypeThreshold {
+				continue
+			}
+			if gain := score * float64(n); bestStart < 0 || gain > bestGain {
+				bestStart, bestEnd, bestGain = from, to, gain
+			}
+		}
+	}
+	if bestStart < 0 {
+		return token, false
+	}
+	return token[:bestStart] + ConvertLayoutToRu(token[bestStart:bestEnd]) + token[bestEnd:], true
+}
+
+// isRussianWordShape сообщает, могут ли слова текста быть русскими: они не начинаются
+// с «ь», «ъ» и «ы», а слова длиннее одной буквы содержат гласную.
+// Так отсекаются "mysql" → "ьныйд" и "lynx" → "днтч".
+func isRussianWordShape(s string) bool {
+	for _, word := range filterLettersPattern.Split(strings.ToLower(s), -1) {
+		runes := []rune(word)
+		if len(runes) == 0 {
+			continue
+		}
+		if strings.ContainsRune("ьъы", runes[0]) || len(runes) > 1 && !strings.ContainsAny(word, "аеёиоуыэюя") {
+			return false
+		}
+	}
+	return true
+}
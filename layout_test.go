@@ -0,0 +1,104 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import "testing"
+
+func TestConvertLayout(t *testing.T) {
+	tests := []struct {
+		name string
+		en   string
+		ru   string
+	}{
+		{"Строчные буквы", "ghbdtn", "привет"},
+		{"Заглавные буквы", "Ghbdtn VBH", "Привет МИР"},
+		{"Знаки на месте букв", "[jxe `krb; k.,k.", "хочу ёлкиж люблю"},
+		{"Заглавные знаки", "{}:\"<>~", "ХЪЖЭБЮЁ"},
+		{"Цифровой ряд", "@#$^&", "\"№;:?"},
+		{"Точка и запятая", "/?", ".,"},
+		{"Пустая строка", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ConvertLayoutToRu(tt.en); got != tt.ru {
+				t.Errorf("ConvertLayoutToRu(%q) = %q; ожидается %q", tt.en, got, tt.ru)
+			}
+			if got := ConvertLayoutToEn(tt.ru); got != tt.en {
+				t.Errorf("ConvertLayoutToEn(%q) = %q; ожидается %q", tt.ru, got, tt.en)
+			}
+		})
+	}
+
+	// Символы вне раскладки не изменяются
+	if got := ConvertLayoutToRu("123 — €"); got != "123 — €" {
+		t.Errorf("ConvertLayoutToRu() = %q; ожидается %q", got, "123 — €")
+	}
+}
+
+func TestLayoutMistypeScore(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		mistyped bool
+	}{
+		{"Русское слово в английской раскладке", "ghbdtn", true},
+		{"Английское слово в русской раскладке", "руддщ", true},
+		{"Английский текст", "the quick brown fox jumps over the lazy dog", false},
+		{"Русский текст", "съешь же ещё этих мягких французских булок", false},
+		{"Русская фраза в английской раскладке", "cgfcb,j pf gjvjom", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score := LayoutMistypeScore(tt.input)
+			if got := score > layoutMistypeThreshold; got != tt.mistyped {
+				t.Errorf("LayoutMistypeScore(%q) = %.2f; ожидается mistyped = %v", tt.input, score, tt.mistyped)
+			}
+		})
+	}
+
+	if got := LayoutMistypeScore("!?"); got != 0 {
+		t.Errorf("LayoutMistypeScore(%q) = %.2f; ожидается 0", "!?", got)
+	}
+}
+
+func TestFixLayout(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		changed bool
+	}{
+		{"Русская фраза", "Ghbdtn! Rfr ltkf?", "Привет! Как дела?", true},
+		{"Английское слово", "руддщ world", "hello world", true},
+		{"Смешанный текст", "ghbdtn, мир", "привет, мир", true},
+		{"Знак на месте буквы в начале", "[jxe gbnm", "хочу пить", true},
+		{"Знаки на месте букв в конце", "k.,k.", "люблю", true},
+		{"Запятая внутри слова", "Cgfcb,j", "Спасибо", true},
+		{"Слово через дефис", "xnj-nj", "что-то", true},
+		{"Английская буква на месте русской", "руддщб", "hello,", true},
+		{"Правильный текст", "Привет, world!", "Привет, world!", false},
+		{"Мягкий знак в конце слова", "ltymub", "деньги", true},
+		{"Редкие английские биграммы", "xthysq", "черный", true},
+		{"Слово не начинается с мягкого знака", "mysql", "mysql", false},
+		{"Слово без гласных", "lynx", "lynx", false},
+		{"Короткие слова", "lf vs", "lf vs", false},
+		{"Аббревиатуры", "JSON API", "JSON API", false},
+		{"Адреса и числа", "test@ghbdtn.ru 12345 #ghbdtn", "test@ghbdtn.ru 12345 #ghbdtn", false},
+		{"Пробелы сохраняются", "  ytn\tytn  ", "  нет\tнет  ", true},
+		{"Пустая строка", "", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, changed := FixLayout(tt.input)
+			if got != tt.want || changed != tt.changed {
+				t.Errorf("FixLayout(%q) = %q, %v; ожидается %q, %v", tt.input, got, changed, tt.want, tt.changed)
+			}
+		})
+	}
+}
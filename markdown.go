@@ -0,0 +1,939 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MarkdownOptions — параметры преобразования Markdown в HTML.
+type MarkdownOptions struct {
+	// HardWraps — каждый перевод строки внутри абзаца становится <br>, как в мессенджерах;
+	// по умолчанию перевод строки — мягкий, а разрыв задается двумя пробелами или "\" в конце строки
+	HardWraps bool
+	// Linkify — превращать адреса http:// и https:// в тексте в ссылки
+	Linkify bool
+	// HeadingOffset — сдвиг уровня заголовков: при 2 заголовок "# " выводится как <h3>,
+	// чтобы заголовки комментария не спорили с заголовками страницы; уровень не превышает 6
+	HeadingOffset int
+}
+
+// mdMaxNesting — максимальная вложенность цитат и списков; более глубокая разметка
+// выводится как текст, чтобы вредоносный ввод не исчерпал стек.
+const mdMaxNesting = 32
+
+var (
+	// markdownPolicy — теги, которые порождает MarkdownToHTML; результат дополнительно
+	// очищается этой политикой, поэтому в вывод не может попасть ничего другого.
+	markdownPolicy = &HTMLPolicy{
+		AllowedTags: map[string][]string{
+			"p": nil, "br": nil, "hr": nil, "em": nil, "strong": nil, "del": nil, "code": nil, "pre": nil,
+			"h1": nil, "h2": nil, "h3": nil, "h4": nil, "h5": nil, "h6": nil,
+			"blockquote": nil, "ul": nil, "ol": {"start"}, "li": nil, "a": {"href", "title"},
+		},
+		AllowedURLSchemes: []string{"http", "https", "mailto"},
+		AllowRelativeURLs: true,
+		LinkRel:           []string{"nofollow", "noopener", "noreferrer"},
+	}
+
+	// mdSetextPattern — подчеркивание заголовка "===" (первый уровень) или "---" (второй).
+	mdSetextPattern = regexp.MustCompile(`^(?:=+|-+)[ \t]*$`)
+	// mdAutolinkPattern — автоссылка <scheme:...>.
+	mdAutolinkPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9+.\-]{1,31}:[^\s<>]*$`)
+	// mdEmailPattern — автоссылка <user@example.com>.
+	mdEmailPattern = regexp.MustCompile(`^[a-zA-Z0-9.!#$%&'*+/=?^_{|}~\-]+@[a-zA-Z0-9](?:[a-zA-Z0-9\-]{0,61}[a-zA-Z0-9])?(?:\.[a-zA-Z0-9](?:[a-zA-Z0-9\-]{0,61}[a-zA-Z0-9])?)*$`)
+)
+
+// MarkdownToHTML преобразует Markdown пользовательских комментариев в безопасный HTML.
+// Поддерживается подмножество CommonMark: абзацы, заголовки (# и подчеркивание), цитаты,
+// маркированные и нумерованные списки, блоки кода (``` и отступ), горизонтальные линии,
+// выделение (*, _, **, __, ~~), код в строке, ссылки [текст](адрес "заголовок") и <адрес>.
+// Безопасность гарантируется независимо от ввода: HTML в тексте экранируется, ссылки
+// допускаются только со схемами http, https, mailto и относительные, изображения выводятся
+// ссылками, всем ссылкам добавляется rel="nofollow noopener noreferrer", а результат
+// дополнительно очищается политикой, разрешающей только порождаемые теги.
+func MarkdownToHTML(s string, opts MarkdownOptions) string {
+	r := &mdRenderer{opts: opts}
+	r.blocks(mdLines(s), false, 0)
+	return strings.TrimSpace(markdownPolicy.Sanitize(r.b.String()))
+}
+
+// MarkdownToText преобразует Markdown в простой текст для уведомлений (писем, SMS, push):
+// разметка выделения удаляется, структура абзацев, списков и цитат сохраняется,
+// а адреса ссылок выводятся согласно opts (см. HTMLToText).
+func MarkdownToText(s string, opts HTMLToTextOptions) string {
+	return HTMLToText(MarkdownToHTML(s, MarkdownOptions{}), opts)
+}
+
+// mdRenderer формирует HTML из блоков Markdown.
+type mdRenderer struct {
+	opts   MarkdownOptions
+	b      strings.Builder
+	inLink bool // внутри текста ссылки вложенные ссылки не создаются
+}
+
+// mdLines разбивает текст на строки, заменяя табуляцию в начале строк пробелами (шаг 4).
+// Внутри блоков кода ``` верхнего уровня табуляция сохраняется.
+func mdLines(s string) []string {
+	s = strings.ReplaceAll(strings.ReplaceAll(s, "\r\n", "\n"), "\r", "\n")
+	lines := strings.Split(s, "\n")
+	fence := ""
+	for i, line := range lines {
+		t := strings.TrimLeft(line, " ")
+		switch {
+		case fence != "":
+			if mdIndent(line) < 4 && strings.HasPrefix(t, fence) && strings.TrimRight(t, fence[:1]+" ") == "" {
+				fence = ""
+			}
+			continue
+		case mdIndent(line) < 4:
+			if c, n, ok := mdFence(t); ok {
+				fence = strings.Repeat(string(c), n)
+			}
+		}
+		if !strings.Contains(line, "\t") {
+			continue
+		}
+
+		var b strings.Builder
+		j := 0
+		for ; j < len(line) && (line[j] == ' ' || line[j] == '\t'); j++ {
+			if line[j] == ' ' {
+				b.WriteByte(' ')
+			} else {
+				b.WriteString(strings.Repeat(" ", 4-b.Len()%4))
+			}
+		}
+		lines[i] = b.String() + line[j:]
+	}
+	return lines
+}
+
+// mdIndent возвращает число пробелов в начале строки.
+func mdIndent(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
+
+// mdIsBlank проверяет, состоит ли строка только из пробелов.
+func mdIsBlank(line string) bool {
+	return strings.TrimSpace(line) == ""
+}
+
+// mdListMarker — маркер элемента списка.
+type mdListMarker struct {
+	ordered bool
+	delim   byte   // "-", "+", "*" для маркированного списка, "." или ")" для нумерованного
+	start   int    // номер элемента нумерованного списка
+	width   int    // ширина маркера вместе с пробелами до текста
+	content string // текст первой строки элемента
+}
+
+// mdParseListMarker разбирает маркер элемента списка в начале строки без отступа.
+func mdParseListMarker(t string) (mdListMarker, bool) {
+	var m mdListMarker
+	n := 0
+	switch {
+	case t == "":
+		return m, false
+	case t[0] == '-' || t[0] == '+' || t[0] == '*':
+		m.delim, n = t[0], 1
+	default:
+		for n < len(t) && n < 10 && t[n] >= '0' && t[n] <= '9' {
+			n++
+		}
+		if n == 0 || n > 9 || n >= len(t) || (t[n] != '.' && t[n] != ')') {
+			return m, false
+		}
+		m.ordered, m.delim = true, t[n]
+		m.start, _ = strconv.Atoi(t[:n])
+		n++
+	}
+
+	rest := t[n:]
+	if rest != "" && rest[0] != ' ' {
+		return m, false
+	}
+	spaces := mdIndent(rest)
+	m.content = rest[spaces:]
+	if spaces == 0 || spaces > 4 || m.content == "" {
+		// Текст с большим отступом считается блоком кода внутри элемента
+		spaces = 1
+		m.content = strings.TrimPrefix(rest, " ")
+	}
+	m.width = n + spaces
+	return m, true
+}
+
+// mdIsListItem проверяет, начинается ли строка с маркера элемента списка.
+func mdIsListItem(line string) bool {
+	_, ok := mdParseListMarker(line[mdIndent(line):])
+	return ok && mdIndent(line) < 4
+}
+
+// mdFence разбирает открывающую строку блока кода ``` или ~~~.
+func mdFence(t string) (fence byte, n int, ok bool) {
+	if t == "" || (t[0] != '`' && t[0] != '~') {
+		return 0, 0, false
+	}
+	fence = t[0]
+	for n < len(t) && t[n] == fence {
+		n++
+	}
+	if n < 3 || (fence == '`' && strings.IndexByte(t[n:], '`') >= 0) {
+		return 0, 0, false
+	}
+	return fence, n, true
+}
+
+// mdHeading разбирает заголовок "# Текст #"; возвращает уровень и текст.
+func mdHeading(t string) (int, string, bool) {
+	level := 0
+	for level < len(t) && t[level] == '#' {
+		level++
+	}
+	if level == 0 || level > 6 || (level < len(t) && t[level] != ' ') {
+		return 0, "", false
+	}
+	text := strings.TrimSpace(t[level:])
+	// Необязательная закрывающая последовательность "#"
+	if trimmed := strings.TrimRight(text, "#"); trimmed == "" || strings.HasSuffix(trimmed, " ") {
+		text = strings.TrimSpace(trimmed)
+	}
+	return level, text, true
+}
+
+// mdIsThematicBreak проверяет, является ли строка горизонтальной линией: "---", "***", "_ _ _".
+func mdIsThematicBreak(t string) bool {
+	t = strings.ReplaceAll(t, " ", "")
+	if len(t) < 3 || (t[0] != '-' && t[0] != '*' && t[0] != '_') {
+		return false
+	}
+	return strings.Count(t, t[:1]) == len(t)
+}
+
+// mdStartsBlock проверяет, начинает ли строка (без отступа) новый блок, прерывающий абзац.
+func mdStartsBlock(t string) bool {
+	if _, _, ok := mdFence(t); ok {
+		return true
+	}
+	if _, _, ok := mdHeading(t); ok {
+		return true
+	}
+	if mdIsThematicBreak(t) || strings.HasPrefix(t, ">") {
+		return true
+	}
+	m, ok := mdParseListMarker(t)
+	return ok && strings.TrimSpace(m.content) != "" && (!m.ordered || m.start == 1)
+}
+
+// blockStart начинает блочный элемент с новой строки.
+func (r *mdRenderer) blockStart() {
+	if n := r.b.Len(); n > 0 && r.b.String()[n-1] != '\n' {
+		r.b.WriteByte('\n')
+	}
+}
+
+// blocks выводит последовательность блоков. В плотном списке (tight) абзацы
+// выводятся без <p>; depth — текущая вложенность цитат и списков.
+func (r *mdRenderer) blocks(lines []string, tight bool, depth int) {
+	var paragraph []string
+	flush := func() {
+		if len(paragraph) == 0 {
+			return
+		}
+		text := r.inline(strings.TrimSpace(strings.Join(paragraph, "\n")))
+		paragraph = nil
+		if tight {
+			r.b.WriteString(text)
+			return
+		}
+		r.blockStart()
+		r.b.WriteString("<p>" + text + "</p>\n")
+	}
+
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		if mdIsBlank(line) {
+			flush()
+			i++
+			continue
+		}
+
+		indent := mdIndent(line)
+		t := line[indent:]
+		if indent >= 4 {
+			if len(paragraph) > 0 {
+				paragraph = append(paragraph, t)
+				i++
+				continue
+			}
+			i = r.indentedCode(lines, i)
+			continue
+		}
+
+		if fence, n, ok := mdFence(t); ok {
+			flush()
+			i = r.fencedCode(lines, i, indent, fence, n)
+			continue
+		}
+		if level, text, ok := mdHeading(t); ok {
+			flush()
+			r.heading(level, text)
+			i++
+			continue
+		}
+		if len(paragraph) > 0 && mdSetextPattern.MatchString(t) {
+			level := 1
+			if t[0] == '-' {
+				level = 2
+			}
+			text := strings.TrimSpace(strings.Join(paragraph, "\n"))
+			paragraph = nil
+			r.heading(level, text)
+			i++
+			continue
+		}
+		if mdIsThematicBreak(t) {
+			flush()
+			r.blockStart()
+			r.b.WriteString("<hr>\n")
+			i++
+			continue
+		}
+		if depth < mdMaxNesting && strings.HasPrefix(t, ">") {
+			flush()
+			i = r.blockquote(lines, i, depth)
+			continue
+		}
+		if m, ok := mdParseListMarker(t); ok && depth < mdMaxNesting &&
+			(len(paragraph) == 0 || (strings.TrimSpace(m.content) != "" && (!m.ordered || m.start == 1))) {
+			flush()
+			i = r.list(lines, i, depth)
+			continue
+		}
+
+		paragraph = append(paragraph, t)
+		i++
+	}
+	flush()
+}
+
+// heading выводит заголовок с учетом HeadingOffset.
+func (r *mdRenderer) heading(level int, text string) {
+	level = min(max(level+r.opts.HeadingOffset, 1), 6)
+	tag := "h" + strconv.Itoa(level)
+	r.blockStart()
+	r.b.WriteString("<" + tag + ">" + r.inline(text) + "</" + tag + ">\n")
+}
+
+// code выводит блок кода.
+func (r *mdRenderer) code(lines []string) {
+	r.blockStart()
+	r.b.WriteString("<pre><code>")
+	for _, line := range lines {
+		r.b.WriteString(html.EscapeString(line) + "\n")
+	}
+	r.b.WriteString("</code></pre>\n")
+}
+
+// indentedCode выводит блок кода с отступом 4 пробела и возвращает индекс следующей строки.
+func (r *mdRenderer) indentedCode(lines []string, i int) int {
+	var code []string
+	for ; i < len(lines) && (mdIsBlank(lines[i]) || mdIndent(lines[i]) >= 4); i++ {
+		line := lines[i]
+		if mdIsBlank(line) {
+			line = ""
+		}
+		code = append(code, line[min(4, len(line)):])
+	}
+	for len(code) > 0 && code[len(code)-1] == "" {
+		code = code[:len(code)-1]
+	}
+	r.code(code)
+	return i
+}
+
+// fencedCode выводит блок кода между строками ``` (или ~~~) и возвращает индекс следующей строки.
+// Незакрытый блок продолжается до конца текста.
+func (r *mdRenderer) fencedCode(lines []string, i, indent int, fence byte, n int) int {
+	var code []string
+	for i++; i < len(lines); i++ {
+		line := lines[i]
+		if t := strings.TrimLeft(line, " "); mdIndent(line) < 4 && strings.HasPrefix(t, strings.Repeat(string(fence), n)) &&
+			strings.TrimRight(t, string(fence)+" ") == "" {
+			i++
+			break
+		}
+		code = append(code, line[min(indent, mdIndent(line)):])
+	}
+	r.code(code)
+	return i
+}
+
+// blockquote выводит цитату и возвращает индекс следующей строки.
+// Строки абзаца без ">" продолжают цитату (ленивое продолжение).
+func (r *mdRenderer) blockquote(lines []string, i, depth int) int {
+	var inner []string
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		t := line[mdIndent(line):]
+		if mdIndent(line) < 4 && strings.HasPrefix(t, ">") {
+			t = t[1:]
+			if strings.HasPrefix(t, " ") {
+				t = t[1:]
+			}
+			inner = append(inner, t)
+			continue
+		}
+		if mdIsBlank(line) || len(inner) == 0 || mdIsBlank(inner[len(inner)-1]) || mdStartsBlock(t) {
+			break
+		}
+		inner = append(inner, line)
+	}
+
+	r.blockStart()
+	r.b.WriteString("<blockquote>\n")
+	r.blocks(inner, false, depth+1)
+	r.blockStart()
+	r.b.WriteString("</blockquote>\n")
+	return i
+}
+
+// list выводит список и возвращает индекс следующей строки. Список, элементы которого
+// разделены пустыми строками, считается свободным: текст элементов оборачивается в <p>.
+func (r *mdRenderer) list(lines []string, i, depth int) int {
+	first, _ := mdParseListMarker(lines[i][mdIndent(lines[i]):])
+	var items [][]string
+	loose := false
+
+	for i < len(lines) {
+		line := lines[i]
+		m, ok := mdParseListMarker(line[mdIndent(line):])
+		if !ok || mdIndent(line) >= 4 || m.ordered != first.ordered || m.delim != first.delim {
+			break
+		}
+		if len(items) > 0 && mdIsBlank(lines[i-1]) {
+			loose = true
+		}
+
+		contentIndent := mdIndent(line) + m.width
+		item := []string{m.content}
+		blank := false
+		for i++; i < len(lines); i++ {
+			line := lines[i]
+			switch {
+			case mdIsBlank(line):
+				blank = true
+				item = append(item, "")
+				continue
+			case mdIndent(line) >= contentIndent:
+				if blank {
+					loose = true
+				}
+				blank = false
+				item = append(item, line[contentIndent:])
+				continue
+			case !blank && !mdIsBlank(item[len(item)-1]) && !mdStartsBlock(line[mdIndent(line):]) && !mdIsListItem(line):
+				// Ленивое продолжение абзаца
+				item = append(item, line)
+				continue
+			}
+			break
+		}
+		for len(item) > 0 && mdIsBlank(item[len(item)-1]) {
+			item = item[:len(item)-1]
+		}
+		items = append(items, item)
+	}
+
+	tag := "ul"
+	r.blockStart()
+	if first.ordered {
+		tag = "ol"
+		if first.start != 1 {
+			r.b.WriteString(`<ol start="` + strconv.Itoa(first.start) + `">` + "\n")
+		} else {
+			r.b.WriteString("<ol>\n")
+		}
+	} else {
+		r.b.WriteString("<ul>\n")
+	}
+	for _, item := range items {
+		r.b.WriteString("<li>")
+		r.blocks(item, !loose, depth+1)
+		if loose {
+			r.blockStart()
+		}
+		r.b.WriteString("</li>\n")
+	}
+	r.b.WriteString("</" + tag + ">\n")
+	return i
+}
+
+// mdInline — состояние разбора строчных элементов одного блока.
+type mdInline struct {
+	s        string
+	out      strings.Builder
+	text     strings.Builder // литеральный текст, ожидающий экранирования
+	brackets map[int]int     // парные квадратные скобки
+	failed   map[[2]byte]bool
+}
+
+// inline преобразует строчную разметку блока в HTML.
+func (r *mdRenderer) inline(s string) string {
+	in := &mdInline{s: s, failed: make(map[[2]byte]bool)}
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch c {
+		case '\\':
+			if i+1 < len(s) && s[i+1] == '\n' {
+				in.write("<br>\n")
+				i += 2
+				continue
+			}
+			if i+1 < len(s) && mdIsASCIIPunct(s[i+1]) {
+				in.write(html.EscapeString(s[i+1 : i+2]))
+				i += 2
+				continue
+			}
+
+		case '`':
+			n := mdRun(s, i)
+			if code, next, ok := in.codeSpan(i, n); ok {
+				in.write("<code>" + html.EscapeString(code) + "</code>")
+				i = next
+				continue
+			}
+			in.text.WriteString(s[i : i+n])
+			i += n
+			continue
+
+		case '*', '_', '~':
+			n := mdRun(s, i)
+			if out, next, ok := r.emphasis(in, i, n); ok {
+				in.write(out)
+				i = next
+				continue
+			}
+			in.text.WriteString(s[i : i+n])
+			i += n
+			continue
+
+		case '!', '[':
+			start := i
+			if c == '!' {
+				start++
+			}
+			if start < len(s) && s[start] == '[' {
+				if out, next, ok := r.link(in, start, c == '!'); ok {
+					in.write(out)
+					i = next
+					continue
+				}
+			}
+
+		case '<':
+			if out, next, ok := r.autolink(s, i); ok {
+				in.write(out)
+				i = next
+				continue
+			}
+
+		case '\n':
+			// Два пробела в конце строки — жесткий перенос
+			text := in.text.String()
+			trimmed := strings.TrimRight(text, " ")
+			in.text.Reset()
+			in.text.WriteString(trimmed)
+			if r.opts.HardWraps || len(text)-len(trimmed) >= 2 {
+				in.write("<br>\n")
+			} else {
+				in.write("\n")
+			}
+			for i++; i < len(s) && s[i] == ' '; i++ {
+			}
+			continue
+
+		case 'h', 'H':
+			if out, next, ok := r.bareURL(s, i); ok {
+				in.write(out)
+				i = next
+				continue
+			}
+		}
+		in.text.WriteByte(c)
+		i++
+	}
+	in.write("")
+	return in.out.String()
+}
+
+// write выводит накопленный текст (HTML-сущности в нем допускаются) и затем готовый HTML.
+func (in *mdInline) write(htmlText string) {
+	if in.text.Len() > 0 {
+		in.out.WriteString(html.EscapeString(html.UnescapeString(in.text.String())))
+		in.text.Reset()
+	}
+	in.out.WriteString(htmlText)
+}
+
+// mdRun возвращает длину серии одинаковых символов, начинающейся с позиции i.
+func mdRun(s string, i int) int {
+	n := 1
+	for i+n < len(s) && s[i+n] == s[i] {
+		n++
+	}
+	return n
+}
+
+// mdIsASCIIPunct проверяет, является ли байт знаком препинания ASCII (их можно экранировать "\").
+func mdIsASCIIPunct(c byte) bool {
+	return c < utf8.RuneSelf && (unicode.IsPunct(rune(c)) || unicode.IsSymbol(rune(c)))
+}
+
+// mdIsPunct проверяет, является ли руна знаком препинания или символом.
+func mdIsPunct(r rune) bool {
+	return unicode.IsPunct(r) || unicode.IsSymbol(r)
+}
+
+// codeSpan разбирает код в строке, начинающийся серией из n обратных кавычек в позиции i.
+func (in *mdInline) codeSpan(i, n int) (string, int, bool) {
+	key := [2]byte{'`', byte(min(n, 255))}
+	if in.failed[key] {
+		return "", 0, false
+	}
+	s := in.s
+	for j := i + n; j < len(s); {
+		if s[j] != '`' {
+			j++
+			continue
+		}
+		m := mdRun(s, j)
+		if m == n {
+			code := strings.ReplaceAll(s[i+n:j], "\n", " ")
+			if len(code) > 2 && code[0] == ' ' && code[len(code)-1] == ' ' && strings.TrimSpace(code) != "" {
+				code = code[1 : len(code)-1]
+			}
+			return code, j + m, true
+		}
+		j += m
+	}
+	in.failed[key] = true
+	return "", 0, false
+}
+
+// mdFlanking определяет, может ли серия s[start:end] символов выделения открывать и закрывать выделение
+// (правила left-/right-flanking CommonMark; "_" внутри слова выделением не считается).
+func mdFlanking(s string, start, end int) (open, closing bool) {
+	before, after := ' ', ' '
+	if start > 0 {
+		before, _ = utf8.DecodeLastRuneInString(s[:start])
+	}
+	if end < len(s) {
+		after, _ = utf8.DecodeRuneInString(s[end:])
+	}
+	left := !unicode.IsSpace(after) && (!mdIsPunct(after) || unicode.IsSpace(before) || mdIsPunct(before))
+	right := !unicode.IsSpace(before) && (!mdIsPunct(before) || unicode.IsSpace(after) || mdIsPunct(after))
+	if s[start] == '_' {
+		return left && (!right || mdIsPunct(before)), right && (!left || mdIsPunct(after))
+	}
+	return left, right
+}
+
+// emphasis разбирает выделение, начинающееся серией из n символов в позиции i.
+func (r *mdRenderer) emphasis(in *mdInline, i, n int) (string, int, bool) {
+	s, c := in.s, in.s[i]
+	if open, _ := mdFlanking(s, i, i+n); !open {
+		return "", 0, false
+	}
+	for size := min(n, 3); size >= 1; size-- {
+		if c == '~' && size != 2 {
+			continue
+		}
+		j := in.closer(i+n, c, size)
+		if j < 0 {
+			continue
+		}
+		inner := r.inline(s[i+n : j])
+		switch {
+		case c == '~':
+			inner = "<del>" + inner + "</del>"
+		case size == 1:
+			inner = "<em>" + inner + "</em>"
+		case size == 2:
+			inner = "<strong>" + inner + "</strong>"
+		default:
+			inner = "<em><strong>" + inner + "</strong></em>"
+		}
+		// Лишние символы открывающей серии остаются текстом
+		return html.EscapeString(s[i:i+n-size]) + inner, j + size, true
+	}
+	return "", 0, false
+}
+
+// closer ищет закрывающую серию символов c длиной size, начиная с позиции from;
+// серия точно такой длины предпочтительнее более длинной. Возвращает -1, если ее нет.
+func (in *mdInline) closer(from int, c byte, size int) int {
+	key := [2]byte{c, byte(size)}
+	if in.failed[key] {
+		return -1
+	}
+	s := in.s
+	longer := -1
+	for j := from; j < len(s); {
+		switch s[j] {
+		case '\\':
+			j += 2
+			continue
+		case '`':
+			n := mdRun(s, j)
+			if _, next, ok := in.codeSpan(j, n); ok {
+				j = next
+			} else {
+				j += n
+			}
+			continue
+		case c:
+			m := mdRun(s, j)
+			if _, closing := mdFlanking(s, j, j+m); closing && m >= size {
+				if m == size {
+					return j
+				}
+				if longer < 0 {
+					longer = j
+				}
+			}
+			j += m
+			continue
+		}
+		j++
+	}
+	if longer < 0 {
+		in.failed[key] = true
+	}
+	return longer
+}
+
+// link разбирает ссылку [текст](адрес "заголовок") с открывающей скобкой в позиции i;
+// image — ссылка записана как изображение ![описание](адрес) и выводится ссылкой с описанием.
+// Ссылка с недопустимой схемой выводится только текстом.
+func (r *mdRenderer) link(in *mdInline, i int, image bool) (string, int, bool) {
+	if r.inLink {
+		return "", 0, false
+	}
+	if in.brackets == nil {
+		in.brackets = mdMatchBrackets(in.s)
+	}
+	j, ok := in.brackets[i]
+	s := in.s
+	if !ok || j+1 >= len(s) || s[j+1] != '(' {
+		return "", 0, false
+	}
+	dest, title, end, ok := in.linkDestination(j + 2)
+	if !ok {
+		return "", 0, false
+	}
+
+	r.inLink = true
+	label := r.inline(s[i+1 : j])
+	r.inLink = false
+	if strings.TrimSpace(label) == "" {
+		label = html.EscapeString(dest)
+	}
+	dest = strings.ReplaceAll(dest, " ", "%20")
+	if dest == "" || !markdownPolicy.isAllowedURL(dest) {
+		return label, end, true
+	}
+	out := `<a href="` + html.EscapeString(dest) + `"`
+	if title != "" {
+		out += ` title="` + html.EscapeString(title) + `"`
+	}
+	return out + ">" + label + "</a>", end, true
+}
+
+// mdMatchBrackets находит парные квадратные скобки, пропуская экранированные символы и код в строке.
+func mdMatchBrackets(s string) map[int]int {
+	pairs := make(map[int]int)
+	var stack []int
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '`':
+			n := mdRun(s, i)
+			if j := strings.Index(s[i+n:], s[i:i+n]); j >= 0 {
+				i += n + j + n - 1
+			} else {
+				i += n - 1
+			}
+		case '[':
+			stack = append(stack, i)
+		case ']':
+			if len(stack) > 0 {
+				pairs[stack[len(stack)-1]] = i
+				stack = stack[:len(stack)-1]
+			}
+		}
+	}
+	return pairs
+}
+
+// linkDestination разбирает адрес и заголовок ссылки после "(" в позиции i
+// и возвращает позицию после закрывающей скобки. Вложенность скобок в адресе
+// ограничена mdMaxNesting.
+func (in *mdInline) linkDestination(i int) (dest, title string, end int, ok bool) {
+	s := in.s
+	skipSpace := func() {
+		for i < len(s) && (s[i] == ' ' || s[i] == '\n') {
+			i++
+		}
+	}
+	skipSpace()
+
+	if i < len(s) && s[i] == '<' {
+		j := strings.IndexAny(s[i+1:], ">\n<")
+		if j < 0 || s[i+1+j] != '>' {
+			return "", "", 0, false
+		}
+		dest = s[i+1 : i+1+j]
+		i += j + 2
+	} else {
+		start, depth := i, 0
+	scan:
+		for i < len(s) {
+			switch c := s[i]; {
+			case c == '\\' && i+1 < len(s):
+				i += 2
+				continue
+			case c == '(':
+				if depth++; depth > mdMaxNesting {
+					return "", "", 0, false
+				}
+			case c == ')':
+				if depth == 0 {
+					break scan
+				}
+				depth--
+			case c <= ' ':
+				break scan
+			}
+			i++
+		}
+		dest = s[start:i]
+	}
+
+	spaced := i < len(s) && (s[i] == ' ' || s[i] == '\n')
+	skipSpace()
+	if spaced && i < len(s) && (s[i] == '"' || s[i] == '\'' || s[i] == '(') {
+		closing := s[i]
+		if closing == '(' {
+			closing = ')'
+		}
+		key := [2]byte{'t', closing}
+		if in.failed[key] {
+			return "", "", 0, false
+		}
+		j := i + 1
+		for j < len(s) && s[j] != closing {
+			if s[j] == '\\' {
+				j++
+			}
+			j++
+		}
+		if j >= len(s) {
+			in.failed[key] = true
+			return "", "", 0, false
+		}
+		title = s[i+1 : j]
+		i = j + 1
+		skipSpace()
+	}
+	if i >= len(s) || s[i] != ')' {
+		return "", "", 0, false
+	}
+	return mdUnescape(dest), mdUnescape(title), i + 1, true
+}
+
+// mdUnescape убирает экранирование "\" перед знаками препинания и декодирует HTML-сущности.
+func mdUnescape(s string) string {
+	if strings.IndexByte(s, '\\') >= 0 {
+		var b strings.Builder
+		for i := 0; i < len(s); i++ {
+			if s[i] == '\\' && i+1 < len(s) && mdIsASCIIPunct(s[i+1]) {
+				i++
+			}
+			b.WriteByte(s[i])
+		}
+		s = b.String()
+	}
+	return html.UnescapeString(s)
+}
+
+// autolink разбирает автоссылку <https://...> или <user@example.com> в позиции i.
+func (r *mdRenderer) autolink(s string, i int) (string, int, bool) {
+	j := strings.IndexAny(s[i+1:], "<> \n")
+	if j < 0 || s[i+1+j] != '>' {
+		return "", 0, false
+	}
+	inner := s[i+1 : i+1+j]
+	href := inner
+	switch {
+	case mdEmailPattern.MatchString(inner):
+		href = "mailto:" + inner
+	case !mdAutolinkPattern.MatchString(inner):
+		return "", 0, false
+	}
+	text := html.EscapeString(inner)
+	if r.inLink || !markdownPolicy.isAllowedURL(href) {
+		return text, i + j + 2, true
+	}
+	return `<a href="` + html.EscapeString(href) + `">` + text + "</a>", i + j + 2, true
+}
+
+// bareURL разбирает адрес http:// или https:// в тексте (при включенном Linkify).
+// Завершающие знаки препинания и непарная закрывающая скобка в адрес не входят.
+func (r *mdRenderer) bareURL(s string, i int) (string, int, bool) {
+	if !r.opts.Linkify || r.inLink {
+		return "", 0, false
+	}
+	if i > 0 {
+		if prev, _ := utf8.DecodeLastRuneInString(s[:i]); unicode.IsLetter(prev) || unicode.IsDigit(prev) {
+			return "", 0, false
+		}
+	}
+	rest := strings.ToLower(s[i:min(len(s), i+8)])
+	scheme := len("https://")
+	if !strings.HasPrefix(rest, "https://") {
+		if !strings.HasPrefix(rest, "http://") {
+			return "", 0, false
+		}
+		scheme = len("http://")
+	}
+
+	end := i + scheme
+	for end < len(s) && s[end] > ' ' && s[end] != '<' {
+		end++
+	}
+	for end > i+scheme {
+		c := s[end-1]
+		if strings.IndexByte(".,:;!?\"'*_~", c) >= 0 ||
+			(c == ')' && strings.Count(s[i:end], "(") < strings.Count(s[i:end], ")")) {
+			end--
+			continue
+		}
+		break
+	}
+	if end == i+scheme {
+		return "", 0, false
+	}
+	url := html.EscapeString(s[i:end])
+	return `<a href="` + url + `">` + url + "</a>", end, true
+}
@@ -0,0 +1,260 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMarkdownToHTML(t *testing.T) {
+	const rel = ` rel="nofollow noopener noreferrer"`
+	tests := []struct {
+		name string
+		s    string
+		opts MarkdownOptions
+		want string
+	}{
+		{
+			"Абзацы и выделение",
+			"Привет, **мир** и *курсив*,\n__жирный__, _курсив_ и ~~зачеркнутый~~.\n\nВторой абзац",
+			MarkdownOptions{},
+			"<p>Привет, <strong>мир</strong> и <em>курсив</em>,\n<strong>жирный</strong>, <em>курсив</em> и <del>зачеркнутый</del>.</p>\n<p>Второй абзац</p>",
+		},
+		{
+			"Вложенное выделение",
+			"*a **b** c* и ***оба***",
+			MarkdownOptions{},
+			"<p><em>a <strong>b</strong> c</em> и <em><strong>оба</strong></em></p>",
+		},
+		{
+			"Подчеркивания внутри слов",
+			"snake_case_name и 2*3*4",
+			MarkdownOptions{},
+			"<p>snake_case_name и 2<em>3</em>4</p>",
+		},
+		{
+			"Экранирование",
+			`\*не курсив\* и \_тоже\_`,
+			MarkdownOptions{},
+			"<p>*не курсив* и _тоже_</p>",
+		},
+		{
+			"Код в строке",
+			"Вызовите `fmt.Println(\"<b>\")` или ``a ` b``",
+			MarkdownOptions{},
+			"<p>Вызовите <code>fmt.Println(&#34;&lt;b&gt;&#34;)</code> или <code>a ` b</code></p>",
+		},
+		{
+			"Заголовки",
+			"# Первый\n## Второй ##\nТретий\n---\n####### не заголовок",
+			MarkdownOptions{},
+			"<h1>Первый</h1>\n<h2>Второй</h2>\n<h2>Третий</h2>\n<p>####### не заголовок</p>",
+		},
+		{
+			"Сдвиг уровня заголовков",
+			"# Первый\n\n###### Шестой",
+			MarkdownOptions{HeadingOffset: 2},
+			"<h3>Первый</h3>\n<h6>Шестой</h6>",
+		},
+		{
+			"Горизонтальная линия",
+			"a\n\n* * *\n\nb",
+			MarkdownOptions{},
+			"<p>a</p>\n<hr>\n<p>b</p>",
+		},
+		{
+			"Маркированный список с вложенным",
+			"- один\n- два\n  - вложенный\n- три",
+			MarkdownOptions{},
+			"<ul>\n<li>один</li>\n<li>два\n<ul>\n<li>вложенный</li>\n</ul>\n</li>\n<li>три</li>\n</ul>",
+		},
+		{
+			"Нумерованный список",
+			"3. три\n4. четыре\nпродолжение",
+			MarkdownOptions{},
+			"<ol start=\"3\">\n<li>три</li>\n<li>четыре\nпродолжение</li>\n</ol>",
+		},
+		{
+			"Свободный список",
+			"1. a\n\n2. b",
+			MarkdownOptions{},
+			"<ol>\n<li>\n<p>a</p>\n</li>\n<li>\n<p>b</p>\n</li>\n</ol>",
+		},
+		{
+			"Список после абзаца",
+			"Покупки:\n- хлеб\n- молоко\n\nКонец",
+			MarkdownOptions{},
+			"<p>Покупки:</p>\n<ul>\n<li>хлеб</li>\n<li>молоко</li>\n</ul>\n<p>Конец</p>",
+		},
+		{
+			"Цитаты",
+			"> цитата\nленивая строка\n> > вложенная",
+			MarkdownOptions{},
+			"<blockquote>\n<p>цитата\nленивая строка</p>\n<blockquote>\n<p>вложенная</p>\n</blockquote>\n</blockquote>",
+		},
+		{
+			"Блоки кода",
+			"```go\nif a < b {\n\treturn\n}\n```\n\n    отступ\n    код",
+			MarkdownOptions{},
+			"<pre><code>if a &lt; b {\n\treturn\n}\n</code></pre>\n<pre><code>отступ\nкод\n</code></pre>",
+		},
+		{
+			"Незакрытый блок кода",
+			"~~~\n**не выделение**",
+			MarkdownOptions{},
+			"<pre><code>**не выделение**\n</code></pre>",
+		},
+		{
+			"Переносы строк",
+			"два пробела  \nобратная черта\\\nмягкий\nперенос",
+			MarkdownOptions{},
+			"<p>два пробела<br>\nобратная черта<br>\nмягкий\nперенос</p>",
+		},
+		{
+			"Жесткие переносы",
+			"раз\nдва",
+			MarkdownOptions{HardWraps: true},
+			"<p>раз<br>\nдва</p>",
+		},
+		{
+			"Ссылки",
+			`[сайт](https://example.com "Пример"), [раздел](/docs/a_b), [почта](mailto:info@example.com)`,
+			MarkdownOptions{},
+			`<p><a href="https://example.com" title="Пример"` + rel + `>сайт</a>, <a href="/docs/a_b"` + rel + `>раздел</a>, <a href="mailto:info@example.com"` + rel + `>почта</a></p>`,
+		},
+		{
+			"Изображение выводится ссылкой",
+			"![схема](https://example.com/a.png)",
+			MarkdownOptions{},
+			`<p><a href="https://example.com/a.png"` + rel + `>схема</a></p>`,
+		},
+		{
+			"Автоссылки",
+			"<https://example.com> и <info@example.com>",
+			MarkdownOptions{},
+			`<p><a href="https://example.com"` + rel + `>https://example.com</a> и <a href="mailto:info@example.com"` + rel + `>info@example.com</a></p>`,
+		},
+		{
+			"Адреса в тексте",
+			"См. https://example.com/wiki/Go_(язык). И http://a.ru!",
+			MarkdownOptions{Linkify: true},
+			`<p>См. <a href="https://example.com/wiki/Go_(язык)"` + rel + `>https://example.com/wiki/Go_(язык)</a>. И <a href="http://a.ru"` + rel + `>http://a.ru</a>!</p>`,
+		},
+		{
+			"Адреса в тексте без Linkify",
+			"https://example.com",
+			MarkdownOptions{},
+			"<p>https://example.com</p>",
+		},
+		{
+			"HTML экранируется",
+			"<b>жирный</b> & <img src=x onerror=alert(1)> &amp; &copy;",
+			MarkdownOptions{},
+			"<p>&lt;b&gt;жирный&lt;/b&gt; &amp; &lt;img src=x onerror=alert(1)&gt; &amp; ©</p>",
+		},
+		{
+			"Пустой ввод",
+			" \n\n ",
+			MarkdownOptions{},
+			"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MarkdownToHTML(tt.s, tt.opts); got != tt.want {
+				t.Errorf("MarkdownToHTML(%q) = %q, ожидается %q", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarkdownToHTMLUnsafeLinks(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"javascript", "[клик](javascript:alert(1))", "<p>клик</p>"},
+		{"Регистр и пробелы", "[клик](JaVa%20Script:alert(1)) [x](<java\tscript:alert(1)>)", "<p>клик x</p>"},
+		{"data", "![x](data:text/html;base64,PHNjcmlwdD4=)", "<p>x</p>"},
+		{"vbscript", "<vbscript:msgbox(1)>", "<p>vbscript:msgbox(1)</p>"},
+		{"Сущности в адресе", "[x](&#106;avascript:alert(1))", "<p>x</p>"},
+		{"Кавычки в адресе", `[x](https://a.ru/"onmouseover="alert(1))`, `<p><a href="https://a.ru/&#34;onmouseover=&#34;alert(1)" rel="nofollow noopener noreferrer">x</a></p>`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MarkdownToHTML(tt.s, MarkdownOptions{}); got != tt.want {
+				t.Errorf("MarkdownToHTML(%q) = %q, ожидается %q", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarkdownToHTMLNoRawHTML(t *testing.T) {
+	inputs := []string{
+		"<script>alert(1)</script>",
+		"```\n</code></pre><script>alert(1)</script>\n```",
+		"`</code><script>alert(1)</script>`",
+		"[<script>alert(1)</script>](https://a.ru \"\\\"><script>alert(1)</script>\")",
+		"> <iframe src=javascript:alert(1)>",
+		"- <svg onload=alert(1)>",
+		"# <style>body{}</style>",
+		"**<a href=javascript:alert(1)>x</a>**",
+		"<https://a.ru\"><script>alert(1)</script>>",
+		strings.Repeat(">", 1000) + " глубоко",
+		strings.Repeat("- ", 1000) + "глубоко",
+	}
+	for _, s := range inputs {
+		got := MarkdownToHTML(s, MarkdownOptions{Linkify: true})
+		for _, bad := range []string{"<script", "<iframe", "<svg", "<style", `href="javascript`} {
+			if strings.Contains(strings.ToLower(got), bad) {
+				t.Errorf("MarkdownToHTML(%q) = %q содержит %q", s, got, bad)
+			}
+		}
+	}
+}
+
+func TestMarkdownToText(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		opts HTMLToTextOptions
+		want string
+	}{
+		{
+			"Структура",
+			"# Новый ответ\n\nПривет, **Анна**! Посмотрите `main.go`.\n\n- один\n- два\n\n> цитата",
+			HTMLToTextOptions{},
+			"Новый ответ\n===========\n\nПривет, Анна! Посмотрите main.go.\n\n* один\n* два\n\n> цитата",
+		},
+		{
+			"Ссылки",
+			"[Документация](https://example.com/docs) и <https://example.com>",
+			HTMLToTextOptions{},
+			"Документация (https://example.com/docs) и https://example.com",
+		},
+		{
+			"Ссылки сносками",
+			"[Документация](https://example.com/docs)",
+			HTMLToTextOptions{Links: HTMLLinkFootnote},
+			"Документация [1]\n\n[1] https://example.com/docs",
+		},
+		{
+			"HTML не интерпретируется",
+			"<b>1 < 2</b> &amp; [x](javascript:alert(1))",
+			HTMLToTextOptions{},
+			"<b>1 < 2</b> & x",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MarkdownToText(tt.s, tt.opts); got != tt.want {
+				t.Errorf("MarkdownToText(%q) = %q, ожидается %q", tt.s, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"context"
+	"log/slog"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	// maskEmailPattern — адрес электронной почты в произвольном тексте.
+	maskEmailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9\-]+(?:\.[A-Za-z0-9\-]+)*\.[A-Za-z]{2,}`)
+	// maskCardPattern — от 13 до 19 цифр, возможно разделенных пробелами или дефисами.
+	maskCardPattern = regexp.MustCompile(`\b\d(?:[ \-]?\d){12,18}\b`)
+	// maskPhonePattern — телефонный номер: +7 (916) 123-45-67, 8 916 123 45 67, +44 20 7946 0958,
+	// а также российский номер без кода страны: (916) 123-45-67, (495) 123-45-67 и мобильный 9161234567.
+	maskPhonePattern = regexp.MustCompile(`(?:\+\d{1,3}|\b[78])[ \-]?\(?\d{2,4}\)?(?:[ \-]?\d){6,8}\b` +
+		`|\(\d{3}\)[ \-]?\d{3}(?:[ \-]?\d{2}){2}\b|\b9\d{2}[ \-]?\d{3}(?:[ \-]?\d{2}){2}\b`)
+)
+
+// MaskPhone маскирует телефонный номер, оставляя последние четыре цифры.
+// Номер разбирается так же, как в ClearPhone; российские номера (11 цифр, начинающиеся
+// с 7 или 8) приводятся к виду "+7 *** ***-45-67", номера из 10 цифр — "*** ***-45-67",
+// остальные — "*******4567". Строка без цифр дает пустую строку.
+func MaskPhone(phone string) string {
+	digits := ClearPhone(phone)
+	n := len(digits)
+	switch {
+	case n == 0:
+		return ""
+	case n == 11 && (digits[0] == '7' || digits[0] == '8'):
+		return "+7 *** ***-" + digits[7:9] + "-" + digits[9:]
+	case n == 10:
+		return "*** ***-" + digits[6:8] + "-" + digits[8:]
+	case n <= 4:
+		return strings.Repeat("*", n)
+	}
+	masked := strings.Repeat("*", n-4) + digits[n-4:]
+	if strings.HasPrefix(strings.TrimSpace(phone), "+") {
+		masked = "+" + masked
+	}
+	return masked
+}
+
+// MaskEmail маскирует адрес электронной почты, оставляя первый символ имени и домен:
+// "ivan.petrov@example.com" → "i***@example.com". Адрес разбирается так же, как в ClearEmail;
+// для некорректного адреса возвращается пустая строка.
+func MaskEmail(email string) string {
+	address := ClearEmail(email)
+	at := strings.LastIndexByte(address, '@')
+	if at <= 0 {
+		return ""
+	}
+	_, size := utf8.DecodeRuneInString(address)
+	return address[:size] + "***" + address[at:]
+}
+
+// maskEmailInText маскирует адрес, найденный maskEmailPattern. Адреса, которые отвергает
+// ClearEmail ("a..b@x.com"), маскируются так же, но без нормализации, чтобы не пропадать из текста.
+func maskEmailInText(email string) string {
+	if masked := MaskEmail(email); masked != "" {
+		return masked
+	}
+	at := strings.LastIndexByte(email, '@')
+	return email[:1] + "***" + email[at:]
+}
+
+// MaskCard маскирует номер банковской карты, оставляя последние четыре цифры:
+// "4111 1111 1111 1234" → "**** **** **** 1234". Длина номера не раскрывается.
+// Строка без цифр дает пустую строку.
+func MaskCard(card string) string {
+	digits := FilterDigits(card)
+	if len(digits) < 8 {
+		return strings.Repeat("*", len(digits))
+	}
+	return "**** **** **** " + digits[len(digits)-4:]
+}
+
+// IsLuhnValid проверяет контрольную сумму номера по алгоритму Луна (номера карт, IMEI).
+// Пробелы и дефисы в номере допускаются; другие символы делают номер некорректным.
+func IsLuhnValid(number string) bool {
+	sum, count := 0, 0
+	for i := len(number) - 1; i >= 0; i-- {
+		c := number[i]
+		switch {
+		case c == ' ' || c == '-':
+			continue
+		case c < '0' || c > '9':
+			return false
+		}
+		d := int(c - '0')
+		if count%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		count++
+	}
+	return count > 1 && sum%10 == 0
+}
+
+// MaskPII находит в произвольном тексте адреса электронной почты, номера банковских карт
+// (с корректной контрольной суммой) и телефонные номера и маскирует их
+// функциями MaskEmail, MaskCard и MaskPhone.
+// Пример: "Звоните +7 916 123-45-67" → "Звоните +7 *** ***-45-67".
+func MaskPII(text string) string {
+	text = maskEmailPattern.ReplaceAllStringFunc(text, maskEmailInText)
+	text = maskCardPattern.ReplaceAllStringFunc(text, func(card string) string {
+		if !IsLuhnValid(card) {
+			return card
+		}
+		return MaskCard(card)
+	})
+	return maskPhonePattern.ReplaceAllStringFunc(text, MaskPhone)
+}
+
+// DefaultMaskRules возвращает правила маскирования атрибутов журнала по умолчанию:
+// ключи "phone", "email", "card" и их распространенные варианты.
+func DefaultMaskRules() map[string]func(string) string {
+	return map[string]func(string) string{
+		"phone":       MaskPhone,
+		"tel":         MaskPhone,
+		"mobile":      MaskPhone,
+		"email":       MaskEmail,
+		"mail":        MaskEmail,
+		"card":        MaskCard,
+		"card_number": MaskCard,
+		"pan":         MaskCard,
+	}
+}
+
+// maskRules — правила маскирования с ключами в нижнем регистре.
+type maskRules map[string]func(string) string
+
+// newMaskRules копирует правила, приводя ключи к нижнему регистру; nil означает правила по умолчанию.
+func newMaskRules(rules map[string]func(string) string) maskRules {
+	if rules == nil {
+		rules = DefaultMaskRules()
+	}
+	normalized := make(maskRules, len(rules))
+	for key, fn := range rules {
+		normalized[strings.ToLower(key)] = fn
+	}
+	return normalized
+}
+
+// maskAttr маскирует значение атрибута по его ключу; атрибуты групп обрабатываются рекурсивно.
+func (rules maskRules) maskAttr(a slog.Attr) slog.Attr {
+	a.Value = a.Value.Resolve()
+	if a.Value.Kind() == slog.KindGroup {
+		attrs := a.Value.Group()
+		masked := make([]slog.Attr, len(attrs))
+		for i, attr := range attrs {
+			masked[i] = rules.maskAttr(attr)
+		}
+		return slog.Attr{Key: a.Key, Value: slog.GroupValue(masked...)}
+	}
+	if fn, ok := rules[strings.ToLower(a.Key)]; ok {
+		return slog.String(a.Key, fn(a.Value.String()))
+	}
+	return a
+}
+
+// MaskReplaceAttr возвращает функцию для slog.HandlerOptions.ReplaceAttr, маскирующую значения
+// атрибутов по ключу (без учета регистра). Правила сопоставляют ключу функцию маскирования;
+// при rules == nil используются DefaultMaskRules.
+//
+//	slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{ReplaceAttr: MaskReplaceAttr(nil)})
+func MaskReplaceAttr(rules map[string]func(string) string) func(groups []string, a slog.Attr) slog.Attr {
+	normalized := newMaskRules(rules)
+	return func(_ []string, a slog.Attr) slog.Attr {
+		return normalized.maskAttr(a)
+	}
+}
+
+// maskingHandler — обработчик slog, маскирующий атрибуты перед передачей следующему обработчику.
+type maskingHandler struct {
+	next  slog.Handler
+	rules maskRules
+}
+
+// NewMaskingHandler оборачивает обработчик slog так, что значения атрибутов записи,
+// в том числе добавленных через Logger.With и вложенных в группы, маскируются по ключу
+// (см. MaskReplaceAttr). Подходит для любых обработчиков, включая сторонние.
+func NewMaskingHandler(next slog.Handler, rules map[string]func(string) string) slog.Handler {
+	return &maskingHandler{next: next, rules: newMaskRules(rules)}
+}
+
+// Enabled реализует slog.Handler.
+func (h *maskingHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+// Handle реализует slog.Handler.
+func (h *maskingHandler) Handle(ctx context.Context, r slog.Record) error {
+	masked := slog.NewRecord(r.Time, r.Level, r.Message, r.PC)
+	r.Attrs(func(a slog.Attr) bool {
+		masked.AddAttrs(h.rules.maskAttr(a))
+		return true
+	})
+	return h.next.Handle(ctx, masked)
+}
+
+// WithAttrs реализует slog.Handler.
+func (h *maskingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	masked := make([]slog.Attr, len(attrs))
+	for i, a := range attrs {
+		masked[i] = h.rules.maskAttr(a)
+	}
+	return &maskingHandler{next: h.next.WithAttrs(masked), rules: h.rules}
+}
+
+// WithGroup реализует slog.Handler.
+func (h *maskingHandler) WithGroup(name string) slog.Handler {
+	return &maskingHandler{next: h.next.WithGroup(name), rules: h.rules}
+}
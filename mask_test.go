@@ -0,0 +1,173 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestMaskPhone(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"+7 (916) 123-45-67", "+7 *** ***-45-67"},
+		{"89161234567", "+7 *** ***-45-67"},
+		{"916 123 45 67", "*** ***-45-67"},
+		{"+44 20 7946 0958", "+********0958"},
+		{"12-34", "****"},
+		{"нет номера", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := MaskPhone(tt.input); got != tt.expected {
+				t.Errorf("MaskPhone(%q) = %q; ожидается %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMaskEmail(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"ivan.petrov@example.com", "i***@example.com"},
+		{"  a@example.com ", "a***@example.com"},
+		{"Иван <ivan@example.com>", "i***@example.com"},
+		{"не адрес", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := MaskEmail(tt.input); got != tt.expected {
+				t.Errorf("MaskEmail(%q) = %q; ожидается %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMaskCard(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"4111 1111 1111 1234", "**** **** **** 1234"},
+		{"4111-1111-1111-1111", "**** **** **** 1111"},
+		{"378282246310005", "**** **** **** 0005"},
+		{"1234", "****"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := MaskCard(tt.input); got != tt.expected {
+				t.Errorf("MaskCard(%q) = %q; ожидается %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIsLuhnValid(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{"4111 1111 1111 1111", true},
+		{"4111-1111-1111-1112", false},
+		{"378282246310005", true},
+		{"79927398713", true},
+		{"7992739871x", false},
+		{"0", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := IsLuhnValid(tt.input); got != tt.expected {
+				t.Errorf("IsLuhnValid(%q) = %v; ожидается %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMaskPII(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"Телефон", "Звоните +7 (916) 123-45-67 после обеда", "Звоните +7 *** ***-45-67 после обеда"},
+		{"Телефон через 8", "тел. 8 916 123 45 67.", "тел. +7 *** ***-45-67."},
+		{"Мобильный без кода страны", "Мой номер 9161234567, звоните", "Мой номер *** ***-45-67, звоните"},
+		{"Код города в скобках", "Офис: (495) 123-45-67", "Офис: *** ***-45-67"},
+		{"Мобильный в скобках", "(916) 123 45 67", "*** ***-45-67"},
+		{"Почта", "Пишите на ivan@example.com!", "Пишите на i***@example.com!"},
+		{"Карта", "Оплата картой 4111 1111 1111 1111 прошла", "Оплата картой **** **** **** 1111 прошла"},
+		{"Номер заказа не карта", "Заказ 4111111111111112 оформлен", "Заказ 4111111111111112 оформлен"},
+		{"Некорректная почта", "write a..b@x.com now", "write a***@x.com now"},
+		{"Несколько значений", "a@b.ru, +79161234567", "a***@b.ru, +7 *** ***-45-67"},
+		{"Без данных", "Обычный текст 2025 года", "Обычный текст 2025 года"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MaskPII(tt.input); got != tt.expected {
+				t.Errorf("MaskPII(%q) = %q; ожидается %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMaskReplaceAttr(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{ReplaceAttr: MaskReplaceAttr(nil)}))
+	logger.Info("заказ",
+		slog.String("Email", "ivan@example.com"),
+		slog.Group("user", slog.String("phone", "+79161234567")),
+		slog.Int("id", 42),
+	)
+
+	var entry map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("некорректный JSON: %v", err)
+	}
+	if entry["Email"] != "i***@example.com" {
+		t.Errorf("Email = %v; ожидается %q", entry["Email"], "i***@example.com")
+	}
+	if user, _ := entry["user"].(map[string]any); user["phone"] != "+7 *** ***-45-67" {
+		t.Errorf("user.phone = %v; ожидается %q", entry["user"], "+7 *** ***-45-67")
+	}
+	if entry["id"] != float64(42) {
+		t.Errorf("id = %v; ожидается 42", entry["id"])
+	}
+}
+
+func TestNewMaskingHandler(t *testing.T) {
+	var buf bytes.Buffer
+	rules := map[string]func(string) string{"Card": MaskCard}
+	logger := slog.New(NewMaskingHandler(slog.NewTextHandler(&buf, nil), rules))
+	logger.With("card", "4111111111111111").WithGroup("payment").Info("оплата",
+		slog.Group("source", slog.String("card", "5555 5555 5555 4444")),
+		slog.String("email", "ivan@example.com"),
+	)
+
+	out := buf.String()
+	for _, want := range []string{`card="**** **** **** 1111"`, `payment.source.card="**** **** **** 4444"`, `payment.email=ivan@example.com`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("вывод %q не содержит %q", out, want)
+		}
+	}
+	if strings.Contains(out, "4111111111111111") || strings.Contains(out, "5555 5555") {
+		t.Errorf("номер карты не замаскирован: %q", out)
+	}
+}
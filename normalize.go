@@ -0,0 +1,89 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// NormalizeNFC приводит строку к нормальной форме NFC: составные символы записываются
+// одной кодовой точкой ("е" + U+0308 → "ё"). Рекомендуется для хранения и сравнения текста.
+func NormalizeNFC(s string) string {
+	return norm.NFC.String(s)
+}
+
+// NormalizeNFKC приводит строку к нормальной форме NFKC: помимо композиции, символы совместимости
+// заменяются обычными ("ﬁ" → "fi", "ＡＢＣ" → "ABC", "²" → "2"). Подходит для идентификаторов
+// и поисковых ключей, но не для отображаемого текста.
+func NormalizeNFKC(s string) string {
+	return norm.NFKC.String(s)
+}
+
+// IsInvisible сообщает, является ли руна невидимым символом: управляющим символом
+// (кроме табуляции и перевода строки), символом форматирования (пробелы нулевой ширины,
+// BOM, мягкий перенос, управление направлением текста), селектором варианта
+// или иным игнорируемым по умолчанию символом Unicode (заполнители хангыля и т.п.).
+func IsInvisible(r rune) bool {
+	switch {
+	case r == '\t', r == '\n', r == '\r':
+		return false
+	case unicode.IsControl(r):
+		return true
+	case unicode.Is(unicode.Prepended_Concatenation_Mark, r):
+		// Знаки вроде U+0600 относятся к форматирующим, но отображаются
+		return false
+	}
+	return unicode.Is(unicode.Cf, r) ||
+		unicode.Is(unicode.Variation_Selector, r) ||
+		unicode.Is(unicode.Other_Default_Ignorable_Code_Point, r)
+}
+
+// RemoveInvisible удаляет из строки невидимые символы (см. IsInvisible): пробелы нулевой ширины,
+// BOM, символы управления направлением текста (U+202E и др.), управляющие символы.
+// Соединители и селекторы вариантов внутри эмодзи ("👨‍👩‍👧", "❤️") сохраняются.
+func RemoveInvisible(s string) string {
+	if strings.IndexFunc(s, IsInvisible) < 0 {
+		return s
+	}
+
+	idx := emojiIndexOnce()
+	var b strings.Builder
+	b.Grow(len(s))
+	for cluster := range GraphemeSeq(s) {
+		if _, ok := idx.lookupEmoji(cluster); ok {
+			b.WriteString(cluster)
+			continue
+		}
+		for _, r := range cluster {
+			if !IsInvisible(r) {
+				b.WriteRune(r)
+			}
+		}
+	}
+	return b.String()
+}
+
+// NormalizeSpaces заменяет все виды пробелов Unicode (неразрывный U+00A0, узкий неразрывный U+202F,
+// пробелы фиксированной ширины U+2000–U+200A, идеографический U+3000 и др.) обычным пробелом.
+// Табуляция и переводы строк не изменяются.
+func NormalizeSpaces(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r != ' ' && unicode.Is(unicode.Zs, r) {
+			return ' '
+		}
+		return r
+	}, s)
+}
+
+// CleanUnicode выполняет полную гигиену пользовательского текста: нормализация NFC,
+// удаление невидимых символов и замена всех видов пробелов обычным.
+// В отличие от ClearString, пробелы не схлопываются, а переводы строк сохраняются.
+func CleanUnicode(s string) string {
+	return NormalizeSpaces(RemoveInvisible(NormalizeNFC(strings.ToValidUTF8(s, ""))))
+}
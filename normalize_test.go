@@ -0,0 +1,91 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import "testing"
+
+func TestNormalizeForms(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		nfc   string
+		nfkc  string
+	}{
+		{"Составная ё", "е\u0308ж", "ёж", "ёж"},
+		{"Краткая й", "и\u0306од", "йод", "йод"},
+		{"Лигатура", "ﬁle", "ﬁle", "file"},
+		{"Полноширинные", "ＡＢＣ１", "ＡＢＣ１", "ABC1"},
+		{"Степень", "м²", "м²", "м2"},
+		{"Пустая строка", "", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizeNFC(tt.input); got != tt.nfc {
+				t.Errorf("NormalizeNFC(%q) = %q; ожидается %q", tt.input, got, tt.nfc)
+			}
+			if got := NormalizeNFKC(tt.input); got != tt.nfkc {
+				t.Errorf("NormalizeNFKC(%q) = %q; ожидается %q", tt.input, got, tt.nfkc)
+			}
+		})
+	}
+}
+
+func TestRemoveInvisible(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"Пробел нулевой ширины", "ad\u200bmin", "admin"},
+		{"BOM", "\ufeffтекст", "текст"},
+		{"Управление направлением", "file\u202etxt.exe", "filetxt.exe"},
+		{"Изоляты направления", "\u2066abc\u2069", "abc"},
+		{"Мягкий перенос", "пере\u00adнос", "перенос"},
+		{"Управляющие символы", "a\x00b\x1bc", "abc"},
+		{"Табуляция и перевод строки", "a\tb\nc\r\n", "a\tb\nc\r\n"},
+		{"Заполнитель хангыля", "a\u3164b", "ab"},
+		{"Селектор варианта вне эмодзи", "a\ufe0fb", "ab"},
+		{"Эмодзи сохраняются", "👨\u200d👩\u200d👧 ❤\ufe0f", "👨\u200d👩\u200d👧 ❤\ufe0f"},
+		{"Арабский знак числа", "\u0600١", "\u0600١"},
+		{"Без изменений", "Привет, мир!", "Привет, мир!"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RemoveInvisible(tt.input); got != tt.expected {
+				t.Errorf("RemoveInvisible(%q) = %q; ожидается %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNormalizeSpaces(t *testing.T) {
+	input := "a\u00a0b\u202fc\u2003d\u3000e\tf\ng"
+	if got, want := NormalizeSpaces(input), "a b c d e\tf\ng"; got != want {
+		t.Errorf("NormalizeSpaces(%q) = %q; ожидается %q", input, got, want)
+	}
+}
+
+func TestCleanUnicode(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"Комбинированная очистка", "\ufeffе\u0308ж\u00a0и\u200bгла\n", "ёж игла\n"},
+		{"Некорректный UTF-8", "a\xffb", "ab"},
+		{"Пустая строка", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CleanUnicode(tt.input); got != tt.expected {
+				t.Errorf("CleanUnicode(%q) = %q; ожидается %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,360 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// GrammaticalGender — грамматический род русского слова.
+type GrammaticalGender int
+
+const (
+	GenderMasculine GrammaticalGender = iota // мужской: один рубль
+	GenderFeminine                           // женский: одна копейка
+	GenderNeuter                             // средний: одно евро
+)
+
+// GrammaticalCase — падеж русского языка.
+type GrammaticalCase int
+
+const (
+	CaseNominative    GrammaticalCase = iota // именительный: кто? что?
+	CaseGenitive                             // родительный: кого? чего?
+	CaseDative                               // дательный: кому? чему?
+	CaseAccusative                           // винительный: кого? что?
+	CaseInstrumental                         // творительный: кем? чем?
+	CasePrepositional                        // предложный: о ком? о чем?
+)
+
+// Currency — валюта для записи суммы прописью.
+type Currency int
+
+const (
+	CurrencyRUB Currency = iota // рубли и копейки
+	CurrencyUSD                 // доллары и центы
+	CurrencyEUR                 // евро и центы
+)
+
+// ruNoun — существительное с формами единственного и множественного числа во всех падежах.
+type ruNoun struct {
+	gender GrammaticalGender
+	forms  [6][2]string
+}
+
+// ruHardNoun строит формы существительного мужского рода с основой на твердый согласный:
+// миллион, доллар, цент.
+func ruHardNoun(stem string) ruNoun {
+	return ruNoun{GenderMasculine, [6][2]string{
+		{stem, stem + "ы"},
+		{stem + "а", stem + "ов"},
+		{stem + "у", stem + "ам"},
+		{stem, stem + "ы"},
+		{stem + "ом", stem + "ами"},
+		{stem + "е", stem + "ах"},
+	}}
+}
+
+var (
+	ruNounThousand = ruNoun{GenderFeminine, [6][2]string{
+		{"тысяча", "тысячи"}, {"тысячи", "тысяч"}, {"тысяче", "тысячам"},
+		{"тысячу", "тысячи"}, {"тысячей", "тысячами"}, {"тысяче", "тысячах"},
+	}}
+
+	// ruNounScales — названия разрядов начиная с тысяч.
+	ruNounScales = []ruNoun{
+		ruNounThousand, ruHardNoun("миллион"), ruHardNoun("миллиард"),
+		ruHardNoun("триллион"), ruHardNoun("квадриллион"), ruHardNoun("квинтиллион"),
+	}
+
+	// ruCurrencyNouns — названия основной и разменной денежных единиц.
+	ruCurrencyNouns = map[Currency][2]ruNoun{
+		CurrencyRUB: {
+			{GenderMasculine, [6][2]string{
+				{"рубль", "рубли"}, {"рубля", "рублей"}, {"рублю", "рублям"},
+				{"рубль", "рубли"}, {"рублём", "рублями"}, {"рубле", "рублях"},
+			}},
+			{GenderFeminine, [6][2]string{
+				{"копейка", "копейки"}, {"копейки", "копеек"}, {"копейке", "копейкам"},
+				{"копейку", "копейки"}, {"копейкой", "копейками"}, {"копейке", "копейках"},
+			}},
+		},
+		CurrencyUSD: {ruHardNoun("доллар"), ruHardNoun("цент")},
+		CurrencyEUR: {
+			{GenderNeuter, [6][2]string{
+				{"евро", "евро"}, {"евро", "евро"}, {"евро", "евро"},
+				{"евро", "евро"}, {"евро", "евро"}, {"евро", "евро"},
+			}},
+			ruHardNoun("цент"),
+		},
+	}
+
+	// ruNumeralOne и ruNumeralTwo — формы, зависящие от рода: [род][падеж].
+	ruNumeralOne = [3][6]string{
+		{"один", "одного", "одному", "один", "одним", "одном"},
+		{"одна", "одной", "одной", "одну", "одной", "одной"},
+		{"одно", "одного", "одному", "одно", "одним", "одном"},
+	}
+	ruNumeralTwo = [3][6]string{
+		{"два", "двух", "двум", "два", "двумя", "двух"},
+		{"две", "двух", "двум", "две", "двумя", "двух"},
+		{"два", "двух", "двум", "два", "двумя", "двух"},
+	}
+
+	// ruNumerals — формы остальных числительных по падежам.
+	ruNumerals = map[int][6]string{
+		0:   {"ноль", "ноля", "нолю", "ноль", "нолём", "ноле"},
+		3:   {"три", "трёх", "трём", "три", "тремя", "трёх"},
+		4:   {"четыре", "четырёх", "четырём", "четыре", "четырьмя", "четырёх"},
+		8:   {"восемь", "восьми", "восьми", "восемь", "восемью", "восьми"},
+		40:  {"сорок", "сорока", "сорока", "сорок", "сорока", "сорока"},
+		50:  {"пятьдесят", "пятидесяти", "пятидесяти", "пятьдесят", "пятьюдесятью", "пятидесяти"},
+		60:  {"шестьдесят", "шестидесяти", "шестидесяти", "шестьдесят", "шестьюдесятью", "шестидесяти"},
+		70:  {"семьдесят", "семидесяти", "семидесяти", "семьдесят", "семьюдесятью", "семидесяти"},
+		80:  {"восемьдесят", "восьмидесяти", "восьмидесяти", "восемьдесят", "восемьюдесятью", "восьмидесяти"},
+		90:  {"девяносто", "девяноста", "девяноста", "девяносто", "девяноста", "девяноста"},
+		100: {"сто", "ста", "ста", "сто", "ста", "ста"},
+		200: {"двести", "двухсот", "двумстам", "двести", "двумястами", "двухстах"},
+		300: {"триста", "трёхсот", "трёмстам", "триста", "тремястами", "трёхстах"},
+		400: {"четыреста", "четырёхсот", "четырёмстам", "четыреста", "четырьмястами", "четырёхстах"},
+		500: {"пятьсот", "пятисот", "пятистам", "пятьсот", "пятьюстами", "пятистах"},
+		600: {"шестьсот", "шестисот", "шестистам", "шестьсот", "шестьюстами", "шестистах"},
+		700: {"семьсот", "семисот", "семистам", "семьсот", "семьюстами", "семистах"},
+		800: {"восемьсот", "восьмисот", "восьмистам", "восемьсот", "восемьюстами", "восьмистах"},
+		900: {"девятьсот", "девятисот", "девятистам", "девятьсот", "девятьюстами", "девятистах"},
+	}
+
+	// ruSoftNumerals — числительные на -ь, склоняющиеся как существительные третьего склонения.
+	ruSoftNumerals = map[int]string{
+		5: "пять", 6: "шесть", 7: "семь", 9: "девять", 10: "десять",
+		11: "одиннадцать", 12: "двенадцать", 13: "тринадцать", 14: "четырнадцать", 15: "пятнадцать",
+		16: "шестнадцать", 17: "семнадцать", 18: "восемнадцать", 19: "девятнадцать",
+		20: "двадцать", 30: "тридцать",
+	}
+)
+
+// ruNumeral возвращает числительное n (единицы, 10-19, десятки или сотни) в падеже c.
+func ruNumeral(n int, g GrammaticalGender, c GrammaticalCase) string {
+	switch n {
+	case 1:
+		return ruNumeralOne[g][c]
+	case 2:
+		return ruNumeralTwo[g][c]
+	}
+	if word, ok := ruSoftNumerals[n]; ok {
+		stem := strings.TrimSuffix(word, "ь")
+		switch c {
+		case CaseGenitive, CaseDative, CasePrepositional:
+			return stem + "и"
+		case CaseInstrumental:
+			return stem + "ью"
+		}
+		return word
+	}
+	return ruNumerals[n][c]
+}
+
+// ruNounForm возвращает форму существительного, согласованную с числом n в падеже c.
+// В именительном и винительном падежах после 2-4 используется родительный падеж
+// единственного числа, после 5-20 и 0 — родительный падеж множественного.
+// В остальных падежах существительное стоит в том же падеже, что и числительное.
+func ruNounForm(noun ruNoun, n uint64, c GrammaticalCase) string {
+	category := PluralCategoryOf("ru", float64(n%100), 0)
+	if c == CaseNominative || c == CaseAccusative {
+		switch category {
+		case PluralOne:
+			return noun.forms[c][0]
+		case PluralFew:
+			return noun.forms[CaseGenitive][0]
+		}
+		return noun.forms[CaseGenitive][1]
+	}
+	if category == PluralOne {
+		return noun.forms[c][0]
+	}
+	if n == 0 {
+		return noun.forms[CaseGenitive][1]
+	}
+	return noun.forms[c][1]
+}
+
+// ruTripleWords дописывает в words числительные для трехзначного числа n.
+func ruTripleWords(words []string, n int, g GrammaticalGender, c GrammaticalCase) []string {
+	if h := n / 100 * 100; h > 0 {
+		words = append(words, ruNumeral(h, g, c))
+	}
+	rest := n % 100
+	if rest >= 10 && rest <= 19 {
+		return append(words, ruNumeral(rest, g, c))
+	}
+	if t := rest / 10 * 10; t > 0 {
+		words = append(words, ruNumeral(t, g, c))
+	}
+	if u := rest % 10; u > 0 {
+		words = append(words, ruNumeral(u, g, c))
+	}
+	return words
+}
+
+// ruNumberWords возвращает запись неотрицательного числа прописью.
+func ruNumberWords(n uint64, g GrammaticalGender, c GrammaticalCase) []string {
+	if n == 0 {
+		return []string{ruNumeral(0, g, c)}
+	}
+
+	var triples []int
+	for v := n; v > 0; v /= 1000 {
+		triples = append(triples, int(v%1000))
+	}
+
+	var words []string
+	for scale := len(triples) - 1; scale >= 0; scale-- {
+		k := triples[scale]
+		if k == 0 {
+			continue
+		}
+		if scale == 0 {
+			words = ruTripleWords(words, k, g, c)
+			continue
+		}
+		noun := ruNounScales[scale-1]
+		words = ruTripleWords(words, k, noun.gender, c)
+		words = append(words, ruNounForm(noun, uint64(k), c))
+	}
+	return words
+}
+
+// NumberToWordsRu возвращает целое число прописью на русском языке в роде g и падеже c:
+// NumberToWordsRu(1021, GenderFeminine, CaseNominative) → "одна тысяча двадцать одна".
+func NumberToWordsRu(n int64, g GrammaticalGender, c GrammaticalCase) string {
+	words := ruNumberWords(absInt64(n), g, c)
+	if n < 0 {
+		words = append([]string{"минус"}, words...)
+	}
+	return strings.Join(words, " ")
+}
+
+// AmountToWordsRu возвращает денежную сумму прописью для счетов и договоров:
+// основная единица — словами, разменная — двумя цифрами, первая буква заглавная.
+// AmountToWordsRu(1200.5, CurrencyRUB, CaseNominative) → "Одна тысяча двести рублей 50 копеек".
+// Сумма округляется до копеек.
+func AmountToWordsRu(amount float64, currency Currency, c GrammaticalCase) string {
+	nouns, ok := ruCurrencyNouns[currency]
+	if !ok {
+		nouns = ruCurrencyNouns[CurrencyRUB]
+	}
+	major, minor := splitAmount(amount)
+
+	words := ruNumberWords(major, nouns[0].gender, c)
+	if amount < 0 && (major > 0 || minor > 0) {
+		words = append([]string{"минус"}, words...)
+	}
+	words = append(words, ruNounForm(nouns[0], major, c), fmt.Sprintf("%02d", minor), ruNounForm(nouns[1], minor, c))
+	return Capitalize(strings.Join(words, " "))
+}
+
+// enOnes и enTens — английские числительные.
+var (
+	enOnes = []string{
+		"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine", "ten",
+		"eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen",
+	}
+	enTens   = []string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+	enScales = []string{"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion"}
+
+	// enCurrencyNouns — формы единственного и множественного числа денежных единиц.
+	enCurrencyNouns = map[Currency][2][2]string{
+		CurrencyRUB: {{"ruble", "rubles"}, {"kopeck", "kopecks"}},
+		CurrencyUSD: {{"dollar", "dollars"}, {"cent", "cents"}},
+		CurrencyEUR: {{"euro", "euros"}, {"cent", "cents"}},
+	}
+)
+
+// enTripleWords дописывает в words английскую запись трехзначного числа n.
+func enTripleWords(words []string, n int) []string {
+	if h := n / 100; h > 0 {
+		words = append(words, enOnes[h], "hundred")
+	}
+	rest := n % 100
+	switch {
+	case rest == 0:
+	case rest < 20:
+		words = append(words, enOnes[rest])
+	case rest%10 == 0:
+		words = append(words, enTens[rest/10])
+	default:
+		words = append(words, enTens[rest/10]+"-"+enOnes[rest%10])
+	}
+	return words
+}
+
+// enNumberWords возвращает английскую запись неотрицательного числа.
+func enNumberWords(n uint64) []string {
+	if n == 0 {
+		return []string{enOnes[0]}
+	}
+
+	var triples []int
+	for v := n; v > 0; v /= 1000 {
+		triples = append(triples, int(v%1000))
+	}
+
+	var words []string
+	for scale := len(triples) - 1; scale >= 0; scale-- {
+		if triples[scale] == 0 {
+			continue
+		}
+		words = enTripleWords(words, triples[scale])
+		if scale > 0 {
+			words = append(words, enScales[scale])
+		}
+	}
+	return words
+}
+
+// NumberToWordsEn возвращает целое число прописью на английском языке (американский вариант, без "and"):
+// NumberToWordsEn(1234) → "one thousand two hundred thirty-four".
+func NumberToWordsEn(n int64) string {
+	words := enNumberWords(absInt64(n))
+	if n < 0 {
+		words = append([]string{"minus"}, words...)
+	}
+	return strings.Join(words, " ")
+}
+
+// AmountToWordsEn возвращает денежную сумму прописью на английском языке:
+// AmountToWordsEn(1200.5, CurrencyUSD) → "One thousand two hundred dollars and 50 cents".
+func AmountToWordsEn(amount float64, currency Currency) string {
+	nouns, ok := enCurrencyNouns[currency]
+	if !ok {
+		nouns = enCurrencyNouns[CurrencyUSD]
+	}
+	major, minor := splitAmount(amount)
+
+	words := enNumberWords(major)
+	if amount < 0 && (major > 0 || minor > 0) {
+		words = append([]string{"minus"}, words...)
+	}
+	words = append(words, Plural("en", int64(major), nouns[0][:]...),
+		"and", fmt.Sprintf("%02d", minor), Plural("en", int64(minor), nouns[1][:]...))
+	return Capitalize(strings.Join(words, " "))
+}
+
+// splitAmount разделяет модуль суммы на основную и разменную (сотые) части.
+func splitAmount(amount float64) (uint64, uint64) {
+	cents := uint64(math.Round(RoundFloat(math.Abs(amount), 2) * 100))
+	return cents / 100, cents % 100
+}
+
+// absInt64 возвращает модуль числа без переполнения для math.MinInt64.
+func absInt64(n int64) uint64 {
+	if n < 0 {
+		return uint64(-(n + 1)) + 1
+	}
+	return uint64(n)
+}
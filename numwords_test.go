@@ -0,0 +1,114 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNumberToWordsRu(t *testing.T) {
+	tests := []struct {
+		n    int64
+		g    GrammaticalGender
+		c    GrammaticalCase
+		want string
+	}{
+		{0, GenderMasculine, CaseNominative, "ноль"},
+		{1, GenderMasculine, CaseNominative, "один"},
+		{1, GenderFeminine, CaseNominative, "одна"},
+		{1, GenderNeuter, CaseNominative, "одно"},
+		{2, GenderFeminine, CaseNominative, "две"},
+		{11, GenderMasculine, CaseNominative, "одиннадцать"},
+		{21, GenderFeminine, CaseAccusative, "двадцать одну"},
+		{1200, GenderMasculine, CaseNominative, "одна тысяча двести"},
+		{2001, GenderMasculine, CaseNominative, "две тысячи один"},
+		{5000, GenderMasculine, CaseNominative, "пять тысяч"},
+		{1021, GenderFeminine, CaseNominative, "одна тысяча двадцать одна"},
+		{342, GenderMasculine, CaseGenitive, "трёхсот сорока двух"},
+		{2345, GenderMasculine, CaseGenitive, "двух тысяч трёхсот сорока пяти"},
+		{58, GenderMasculine, CaseInstrumental, "пятьюдесятью восемью"},
+		{1000, GenderMasculine, CaseDative, "одной тысяче"},
+		{3000, GenderMasculine, CaseInstrumental, "тремя тысячами"},
+		{90, GenderMasculine, CasePrepositional, "девяноста"},
+		{1000000, GenderMasculine, CaseNominative, "один миллион"},
+		{2500000000, GenderMasculine, CaseNominative, "два миллиарда пятьсот миллионов"},
+		{-15, GenderMasculine, CaseNominative, "минус пятнадцать"},
+		{math.MinInt64, GenderMasculine, CaseNominative, "минус девять квинтиллионов двести двадцать три квадриллиона триста семьдесят два триллиона тридцать шесть миллиардов восемьсот пятьдесят четыре миллиона семьсот семьдесят пять тысяч восемьсот восемь"},
+	}
+	for _, tt := range tests {
+		if got := NumberToWordsRu(tt.n, tt.g, tt.c); got != tt.want {
+			t.Errorf("NumberToWordsRu(%d) = %q, want %q", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestAmountToWordsRu(t *testing.T) {
+	tests := []struct {
+		amount   float64
+		currency Currency
+		c        GrammaticalCase
+		want     string
+	}{
+		{1200.5, CurrencyRUB, CaseNominative, "Одна тысяча двести рублей 50 копеек"},
+		{1, CurrencyRUB, CaseNominative, "Один рубль 00 копеек"},
+		{2.01, CurrencyRUB, CaseNominative, "Два рубля 01 копейка"},
+		{21.22, CurrencyRUB, CaseAccusative, "Двадцать один рубль 22 копейки"},
+		{0.05, CurrencyRUB, CaseNominative, "Ноль рублей 05 копеек"},
+		{1001.01, CurrencyRUB, CaseGenitive, "Одной тысячи одного рубля 01 копейки"},
+		{5.31, CurrencyRUB, CaseInstrumental, "Пятью рублями 31 копейкой"},
+		{2, CurrencyRUB, CasePrepositional, "Двух рублях 00 копеек"},
+		{3.5, CurrencyUSD, CaseNominative, "Три доллара 50 центов"},
+		{1, CurrencyEUR, CaseNominative, "Одно евро 00 центов"},
+		{-10, CurrencyRUB, CaseNominative, "Минус десять рублей 00 копеек"},
+		{0.999, CurrencyRUB, CaseNominative, "Один рубль 00 копеек"},
+	}
+	for _, tt := range tests {
+		if got := AmountToWordsRu(tt.amount, tt.currency, tt.c); got != tt.want {
+			t.Errorf("AmountToWordsRu(%v) = %q, want %q", tt.amount, got, tt.want)
+		}
+	}
+}
+
+func TestNumberToWordsEn(t *testing.T) {
+	tests := []struct {
+		n    int64
+		want string
+	}{
+		{0, "zero"},
+		{7, "seven"},
+		{13, "thirteen"},
+		{40, "forty"},
+		{99, "ninety-nine"},
+		{100, "one hundred"},
+		{1234, "one thousand two hundred thirty-four"},
+		{1000001, "one million one"},
+		{-512, "minus five hundred twelve"},
+	}
+	for _, tt := range tests {
+		if got := NumberToWordsEn(tt.n); got != tt.want {
+			t.Errorf("NumberToWordsEn(%d) = %q, want %q", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestAmountToWordsEn(t *testing.T) {
+	tests := []struct {
+		amount   float64
+		currency Currency
+		want     string
+	}{
+		{1200.5, CurrencyUSD, "One thousand two hundred dollars and 50 cents"},
+		{1.01, CurrencyUSD, "One dollar and 01 cent"},
+		{21, CurrencyRUB, "Twenty-one rubles and 00 kopecks"},
+		{-0.5, CurrencyEUR, "Minus zero euros and 50 cents"},
+	}
+	for _, tt := range tests {
+		if got := AmountToWordsEn(tt.amount, tt.currency); got != tt.want {
+			t.Errorf("AmountToWordsEn(%v) = %q, want %q", tt.amount, got, tt.want)
+		}
+	}
+}
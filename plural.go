@@ -0,0 +1,155 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// PluralCategory — категория множественного числа по правилам CLDR.
+type PluralCategory int
+
+const (
+	// PluralOther — прочие числа (в русском — дробные: "1,5 файла").
+	PluralOther PluralCategory = iota
+	// PluralOne — "1 файл", "21 файл", "1 file".
+	PluralOne
+	// PluralFew — "2 файла", "23 файла".
+	PluralFew
+	// PluralMany — "5 файлов", "11 файлов".
+	PluralMany
+)
+
+// String возвращает название категории в терминах CLDR.
+func (c PluralCategory) String() string {
+	switch c {
+	case PluralOne:
+		return "one"
+	case PluralFew:
+		return "few"
+	case PluralMany:
+		return "many"
+	}
+	return "other"
+}
+
+// pluralFormOrder — порядок форм слова, передаваемых в Plural, для каждого языка.
+var pluralFormOrder = map[string][]PluralCategory{
+	"ru": {PluralOne, PluralFew, PluralMany, PluralOther},
+	"en": {PluralOne, PluralOther},
+}
+
+// pluralLanguage возвращает основной подтег языка: "ru-RU" → "ru".
+func pluralLanguage(lang string) string {
+	lang = strings.ToLower(lang)
+	if i := strings.IndexAny(lang, "-_"); i >= 0 {
+		lang = lang[:i]
+	}
+	return lang
+}
+
+// PluralCategoryOf возвращает категорию множественного числа для n по правилам CLDR.
+// precision — число отображаемых знаков после запятой (влияет на категорию: "1 файл", но "1,0 файла");
+// при precision < 0 используется кратчайшее представление числа.
+// Поддерживаются языки "ru" и "en"; для прочих языков возвращается PluralOther.
+func PluralCategoryOf(lang string, n float64, precision int) PluralCategory {
+	if math.IsNaN(n) || math.IsInf(n, 0) {
+		return PluralOther
+	}
+
+	// Операнды CLDR: i — целая часть, v — число видимых знаков дробной части.
+	// Для правил достаточно двух последних цифр целой части.
+	s := strconv.FormatFloat(math.Abs(n), 'f', precision, 64)
+	intPart, frac, _ := strings.Cut(s, ".")
+	i100, _ := strconv.Atoi(intPart[max(len(intPart)-2, 0):])
+	return pluralCategory(lang, i100, intPart == "1", len(frac))
+}
+
+// pluralCategoryInt возвращает категорию для целого n, вычисляя операнды CLDR без перевода
+// в float64: числа больше 2^53 в нем теряют последние цифры.
+func pluralCategoryInt(lang string, n int64) PluralCategory {
+	i100 := int(n % 100)
+	if i100 < 0 {
+		i100 = -i100
+	}
+	return pluralCategory(lang, i100, n == 1 || n == -1, 0)
+}
+
+// pluralCategory применяет правила CLDR к операндам: i100 — две последние цифры целой части,
+// one — целая часть равна 1, v — число видимых знаков дробной части.
+func pluralCategory(lang string, i100 int, one bool, v int) PluralCategory {
+	i10 := i100 % 10
+	switch pluralLanguage(lang) {
+	case "ru":
+		switch {
+		case v != 0:
+			return PluralOther
+		case i10 == 1 && i100 != 11:
+			return PluralOne
+		case i10 >= 2 && i10 <= 4 && (i100 < 12 || i100 > 14):
+			return PluralFew
+		default:
+			return PluralMany
+		}
+	case "en":
+		if v == 0 && one {
+			return PluralOne
+		}
+	}
+	return PluralOther
+}
+
+// Plural выбирает форму слова, согласованную с целым числом n.
+// Формы передаются в порядке категорий языка: для "ru" — one, few, many (например,
+// "файл", "файла", "файлов"), для "en" — one, other ("file", "files").
+func Plural(lang string, n int64, forms ...string) string {
+	return pluralForm(lang, pluralCategoryInt(lang, n), forms)
+}
+
+// PluralFloat выбирает форму слова, согласованную с дробным числом n,
+// отображаемым с precision знаками после запятой. Для дробных чисел в русском языке
+// используется четвертая форма (other), а если она не передана — форма few.
+func PluralFloat(lang string, n float64, precision int, forms ...string) string {
+	return pluralForm(lang, PluralCategoryOf(lang, n, precision), forms)
+}
+
+// FormatPlural возвращает число вместе с согласованной формой слова: "5 файлов", "1 file".
+func FormatPlural(lang string, n int64, forms ...string) string {
+	return strconv.FormatInt(n, 10) + " " + Plural(lang, n, forms...)
+}
+
+// FormatPluralFloat возвращает дробное число с precision знаками после запятой
+// вместе с согласованной формой слова: "1,5 файла", "2.50 files".
+// В русском языке дробная часть отделяется запятой.
+func FormatPluralFloat(lang string, n float64, precision int, forms ...string) string {
+	s := strconv.FormatFloat(n, 'f', precision, 64)
+	if pluralLanguage(lang) == "ru" {
+		s = strings.Replace(s, ".", ",", 1)
+	}
+	return s + " " + PluralFloat(lang, n, precision, forms...)
+}
+
+// pluralForm возвращает форму слова для категории c.
+// Недостающие формы заменяются ближайшими: other → few → последняя переданная.
+func pluralForm(lang string, c PluralCategory, forms []string) string {
+	if len(forms) == 0 {
+		return ""
+	}
+	order, ok := pluralFormOrder[pluralLanguage(lang)]
+	if !ok {
+		return forms[len(forms)-1]
+	}
+	for _, want := range []PluralCategory{c, PluralFew} {
+		for idx, category := range order {
+			if category == want && idx < len(forms) {
+				return forms[idx]
+			}
+		}
+	}
+	return forms[len(forms)-1]
+}
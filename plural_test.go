@@ -0,0 +1,85 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPluralCategoryOf(t *testing.T) {
+	tests := []struct {
+		lang      string
+		n         float64
+		precision int
+		want      PluralCategory
+	}{
+		{"ru", 1, 0, PluralOne},
+		{"ru", 21, 0, PluralOne},
+		{"ru", 101, 0, PluralOne},
+		{"ru", 11, 0, PluralMany},
+		{"ru", 111, 0, PluralMany},
+		{"ru", 2, 0, PluralFew},
+		{"ru", 34, 0, PluralFew},
+		{"ru", 12, 0, PluralMany},
+		{"ru", 14, 0, PluralMany},
+		{"ru", 0, 0, PluralMany},
+		{"ru", 5, 0, PluralMany},
+		{"ru", -1, 0, PluralOne},
+		{"ru", 1.5, 1, PluralOther},
+		{"ru", 1, 1, PluralOther},
+		{"ru", 2.5, -1, PluralOther},
+		{"ru", 3, -1, PluralFew},
+		{"ru", 1e21, 0, PluralMany},
+		{"ru-RU", 1, 0, PluralOne},
+		{"en", 1, 0, PluralOne},
+		{"en", 1, 1, PluralOther},
+		{"en", 21, 0, PluralOther},
+		{"en", 0, 0, PluralOther},
+		{"en_US", 1, 0, PluralOne},
+		{"de", 1, 0, PluralOther},
+	}
+	for _, tt := range tests {
+		if got := PluralCategoryOf(tt.lang, tt.n, tt.precision); got != tt.want {
+			t.Errorf("PluralCategoryOf(%q, %v, %d) = %v, want %v", tt.lang, tt.n, tt.precision, got, tt.want)
+		}
+	}
+}
+
+func TestFormatPlural(t *testing.T) {
+	ru := []string{"файл", "файла", "файлов"}
+	en := []string{"file", "files"}
+
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"1", FormatPlural("ru", 1, ru...), "1 файл"},
+		{"2", FormatPlural("ru", 2, ru...), "2 файла"},
+		{"5", FormatPlural("ru", 5, ru...), "5 файлов"},
+		{"11", FormatPlural("ru", 11, ru...), "11 файлов"},
+		{"22", FormatPlural("ru", 22, ru...), "22 файла"},
+		{"Отрицательное", FormatPlural("ru", -21, ru...), "-21 файл"},
+		{"Дробное", FormatPluralFloat("ru", 1.5, 1, ru...), "1,5 файла"},
+		{"Дробное с формой other", FormatPluralFloat("ru", 2.25, 2, "минута", "минуты", "минут", "минуты"), "2,25 минуты"},
+		{"Английский", FormatPlural("en", 1, en...), "1 file"},
+		{"Английский мн.ч.", FormatPlural("en", 3, en...), "3 files"},
+		{"Английский дробное", FormatPluralFloat("en", 1, 1, en...), "1.0 files"},
+		{"Только слово", Plural("ru", 3, ru...), "файла"},
+		{"Больше 2^53", FormatPlural("ru", 100000000000000001, ru...), "100000000000000001 файл"},
+		{"MinInt64", Plural("ru", math.MinInt64, ru...), "файлов"},
+		{"Неизвестный язык", Plural("xx", 1, "a", "b"), "b"},
+		{"Нет форм", Plural("ru", 1), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %q, want %q", tt.got, tt.want)
+			}
+		})
+	}
+}
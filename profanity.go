@@ -0,0 +1,446 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// ErrProfanity возвращается, если текст содержит слова из словаря фильтра.
+var ErrProfanity = errors.New("helpers: текст содержит недопустимые слова")
+
+var (
+	// profanityFold — сведение букв, цифр и знаков, которыми подменяют буквы, к одному символу.
+	// Кириллические «а», «е», «о», «р», «с», «у», «х» заменяет латинскими двойниками Skeleton;
+	// здесь добавляются двойники строчных и прописных форм ("M" и «м», "H" и «н») и цифры
+	// leetspeak ("3" — «з», "4" — «ч», "6" — «б», "@" — «а»). Применяется до Skeleton, пока
+	// буквы не заменены прототипами confusables («к» → "ĸ"), и после него — к символам,
+	// которые Skeleton получает из цифр и знаков ("1" → "l").
+	profanityFold = map[rune]rune{
+		'l': 'i', 'з': '3', 'ч': '4', 'б': '6', 'и': 'u', 'к': 'k', 'м': 'm', 'т': 't',
+		'в': 'b', 'н': 'h', 'п': 'n', 'г': 'r', 'ш': 'w', '@': 'a', '$': 'c',
+	}
+
+	// profanityEndings — окончания словоформ, при которых слово словаря считается найденным
+	// (в нормализованном виде): «дурак» находит «дурака» и «дураками», но не «дуракаваляние».
+	profanityEndings = makeProfanityEndings(ruNounEndings, ruAdjective, []string{"s", "es", "ed", "ing", "er", "ers"})
+
+	// profanitySeparators — одиночные разделители, которыми разбивают слово на буквы: "д у р а к", "д.у.р.а.к".
+	profanitySeparators = " .-_"
+)
+
+// Наименьшее и наибольшее число букв в слове, написанном по буквам.
+const (
+	profanityMinSpelled = 3
+	profanityMaxSpelled = 32
+)
+
+// makeProfanityEndings нормализует окончания словоформ.
+func makeProfanityEndings(lists ...[]string) map[string]struct{} {
+	endings := make(map[string]struct{})
+	for _, list := range lists {
+		for _, ending := range list {
+			endings[string(normalizeProfanity(ending))] = struct{}{}
+		}
+	}
+	return endings
+}
+
+// normalizeProfanity приводит слово к форме для сравнения: нижний регистр, разложение
+// совместимых символов (NFKD: полноширинные буквы), замена символов-двойников (Skeleton),
+// удаление диакритики («й» → «и», «ё» → «е»), сведение leetspeak (profanityFold)
+// и схлопывание повторов ("дуууурак" → "дурак").
+// Символ "*" сохраняется: в тексте он заменяет одну скрытую букву.
+func normalizeProfanity(word string) []rune {
+	word = foldProfanity(norm.NFKD.String(strings.ToLower(word)))
+	word = strings.ToLower(Skeleton(word))
+	out := make([]rune, 0, len(word))
+	for _, r := range word {
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+		if folded, ok := profanityFold[r]; ok {
+			r = folded
+		}
+		if n := len(out); n > 0 && out[n-1] == r && r != '*' {
+			continue
+		}
+		out = append(out, r)
+	}
+	return out
+}
+
+// foldProfanity заменяет символы строки по таблице profanityFold.
+func foldProfanity(s string) string {
+	return strings.Map(func(r rune) rune {
+		if folded, ok := profanityFold[r]; ok {
+			return folded
+		}
+		return r
+	}, s)
+}
+
+// profanityEntry — запись словаря в нормализованном виде.
+type profanityEntry struct {
+	word string // исходная запись
+	key  []rune // нормализованная запись
+	stem []rune // нормализованная основа для поиска словоформ; nil — только точное совпадение
+	root bool   // корень: ищется внутри любых слов
+}
+
+// newProfanityEntry нормализует запись словаря; для слов вычисляется основа (см. Stem).
+// Если основа короче трех букв, ею считается слово без конечной гласной или «й»:
+// так «хуй» находит «хуя» и «хуем», а не только точное совпадение.
+func newProfanityEntry(word string, root bool) (profanityEntry, bool) {
+	word = strings.TrimSpace(word)
+	e := profanityEntry{word: word, key: normalizeProfanity(word), root: root}
+	if len(e.key) == 0 {
+		return e, false
+	}
+	if !root {
+		if stem := normalizeProfanity(Stem(word)); len(stem) >= 3 {
+			e.stem = stem
+		} else if stem := normalizeProfanity(shortProfanityStem(word)); len(stem) >= 2 && len(stem) < len(e.key) {
+			e.stem = stem
+		}
+	}
+	return e, true
+}
+
+// shortProfanityStem возвращает слово без конечной гласной или «й».
+func shortProfanityStem(word string) string {
+	word = strings.ToLower(word)
+	r, size := utf8.DecodeLastRuneInString(word)
+	if strings.ContainsRune("аеёиоуыэюяй", r) {
+		return word[:len(word)-size]
+	}
+	return word
+}
+
+// matches сообщает, соответствует ли нормализованное слово текста записи: корень ищется
+// внутри слова, слово — целиком или с окончанием словоформы. wildcard разрешает "*" в тексте
+// заменять любую букву.
+func (e profanityEntry) matches(token []rune, wildcard bool) bool {
+	if e.root {
+		for i := 0; i+len(e.key) <= len(token); i++ {
+			if profanityEqual(token[i:i+len(e.key)], e.key, wildcard) {
+				return true
+			}
+		}
+		return false
+	}
+	if profanityEqual(token, e.key, wildcard) {
+		return true
+	}
+	if e.stem == nil || len(token) <= len(e.stem) || !profanityEqual(token[:len(e.stem)], e.stem, wildcard) {
+		return false
+	}
+	_, ok := profanityEndings[string(token[len(e.stem):])]
+	return ok
+}
+
+// profanityEqual сравнивает нормализованные строки одинаковой длины с учетом "*" в тексте.
+func profanityEqual(token, key []rune, wildcard bool) bool {
+	if len(token) != len(key) {
+		return false
+	}
+	for i, r := range token {
+		if r != key[i] && !(wildcard && r == '*') {
+			return false
+		}
+	}
+	return true
+}
+
+// ProfanityMatch — найденное недопустимое слово.
+type ProfanityMatch struct {
+	Text  string // слово в исходном тексте
+	Entry string // запись словаря, с которой оно совпало
+	Start int    // байтовое смещение начала слова в тексте
+	End   int    // байтовое смещение конца слова в тексте
+}
+
+// ProfanityFilter находит в тексте слова из настраиваемого словаря с учетом приемов обхода
+// модерации: набора в другой раскладке ("lehfr"), латинских и греческих двойников букв,
+// leetspeak ("дур@к", "uдuот"), повторов букв ("дуууурак"), букв через пробел или точку
+// ("д у р а к") и скрытых звездочкой букв ("д*рак"). Слова находятся во всех словоформах,
+// корни — внутри любых слов; слова из списка исключений не считаются совпадениями.
+// Фильтр рассчитан на русский текст. После заполнения словаря методы поиска
+// безопасны для одновременного использования.
+type ProfanityFilter struct {
+	entries    []profanityEntry
+	exceptions []profanityEntry
+}
+
+// NewProfanityFilter создает фильтр со словарем слов (см. AddWords).
+func NewProfanityFilter(words ...string) *ProfanityFilter {
+	f := &ProfanityFilter{}
+	f.AddWords(words...)
+	return f
+}
+
+// AddWords добавляет слова, которые находятся целиком во всех словоформах:
+// "дурак" находит "дурака" и "дураками", но не "придурок".
+func (f *ProfanityFilter) AddWords(words ...string) {
+	for _, word := range words {
+		if e, ok := newProfanityEntry(word, false); ok {
+			f.entries = append(f.entries, e)
+		}
+	}
+}
+
+// AddRoots добавляет корни, которые находятся внутри любых слов:
+// корень "идиот" находит "идиотский" и "суперидиот".
+func (f *ProfanityFilter) AddRoots(roots ...string) {
+	for _, root := range roots {
+		if e, ok := newProfanityEntry(root, true); ok {
+			f.entries = append(f.entries, e)
+		}
+	}
+}
+
+// AddExceptions добавляет слова, которые не считаются совпадениями, даже если содержат корень
+// из словаря: корень "лох" и исключение "лохматый" (во всех словоформах).
+func (f *ProfanityFilter) AddExceptions(words ...string) {
+	for _, word := range words {
+		if e, ok := newProfanityEntry(word, false); ok {
+			f.exceptions = append(f.exceptions, e)
+		}
+	}
+}
+
+// Find возвращает недопустимые слова текста в порядке следования (режим отчета).
+func (f *ProfanityFilter) Find(text string) []ProfanityMatch {
+	if len(f.entries) == 0 {
+		return nil
+	}
+	var matches []ProfanityMatch
+	for _, token := range profanityTokens(text) {
+		if token.letters == nil {
+			if entry, ok := f.match(token.text); ok {
+				matches = append(matches, ProfanityMatch{Text: token.text, Entry: entry, Start: token.start, End: token.end})
+			}
+			continue
+		}
+		matches = append(matches, f.matchSpelled(text, token.letters)...)
+	}
+
+	// Слова, набранные в английской раскладке, кроме уже найденных
+	direct, k := len(matches), 0
+	for _, field := range profanityFields(text) {
+		for k < direct && matches[k].End <= field.start {
+			k++
+		}
+		if k < direct && matches[k].Start < field.end {
+			continue
+		}
+		if entry, word, ok := f.matchLayout(field.text); ok {
+			matches = append(matches, ProfanityMatch{Text: word, Entry: entry, Start: field.start, End: field.start + len(word)})
+		}
+	}
+
+	slices.SortFunc(matches, func(a, b ProfanityMatch) int { return a.Start - b.Start })
+	return matches
+}
+
+// Contains сообщает, есть ли в тексте недопустимые слова.
+func (f *ProfanityFilter) Contains(text string) bool {
+	return len(f.Find(text)) > 0
+}
+
+// Check возвращает ErrProfanity, если текст содержит недопустимые слова (режим отклонения).
+func (f *ProfanityFilter) Check(text string) error {
+	if f.Contains(text) {
+		return ErrProfanity
+	}
+	return nil
+}
+
+// Mask заменяет в недопустимых словах все символы, кроме первого, звездочками
+// (режим маскирования): "ты дурак!" → "ты д****!". Пробелы внутри слова сохраняются.
+func (f *ProfanityFilter) Mask(text string) string {
+	matches := f.Find(text)
+	if len(matches) == 0 {
+		return text
+	}
+
+	var b strings.Builder
+	b.Grow(len(text))
+	last := 0
+	for _, m := range matches {
+		b.WriteString(text[last:m.Start])
+		_, size := utf8.DecodeRuneInString(m.Text)
+		b.WriteString(m.Text[:size])
+		for _, r := range m.Text[size:] {
+			if unicode.IsSpace(r) {
+				b.WriteRune(r)
+			} else {
+				b.WriteByte('*')
+			}
+		}
+		last = m.End
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
+
+// match проверяет слово текста по словарю и возвращает совпавшую запись.
+// Звездочки по краям слова также пробуются как разметка ("*дурак*").
+func (f *ProfanityFilter) match(word string) (string, bool) {
+	candidates := []string{word}
+	if trimmed := strings.Trim(word, "*"); trimmed != word && trimmed != "" {
+		candidates = append(candidates, trimmed)
+	}
+	for _, candidate := range candidates {
+		if entry, ok := f.matchNormalized(normalizeProfanity(candidate)); ok {
+			return entry, true
+		}
+	}
+	return "", false
+}
+
+// matchNormalized проверяет нормализованное слово по словарю с учетом исключений.
+func (f *ProfanityFilter) matchNormalized(token []rune) (string, bool) {
+	// "*" заменяет букву, только если букв в слове больше, чем звездочек
+	stars := 0
+	for _, r := range token {
+		if r == '*' {
+			stars++
+		}
+	}
+	wildcard := stars > 0 && stars < len(token)-stars
+
+	if slices.ContainsFunc(f.exceptions, func(e profanityEntry) bool { return e.matches(token, false) }) {
+		return "", false
+	}
+	for _, e := range f.entries {
+		if e.matches(token, wildcard) {
+			return e.word, true
+		}
+	}
+	return "", false
+}
+
+// matchSpelled ищет слова в последовательности одиночных букв ("ты д у р а к и"):
+// с каждой позиции пробуется самое длинное совпадающее слово, но не длиннее profanityMaxSpelled букв.
+func (f *ProfanityFilter) matchSpelled(text string, letters []profanityToken) []ProfanityMatch {
+	normalized := make([][]rune, len(letters))
+	for i, letter := range letters {
+		normalized[i] = normalizeProfanity(letter.text)
+	}
+
+	var matches []ProfanityMatch
+	for i := 0; i < len(letters); i++ {
+		// Нормализованные слова из букв i..j для всех j; повторы на стыках букв схлопываются
+		var words [][]rune
+		var word []rune
+		for _, runes := range normalized[i:min(len(letters), i+profanityMaxSpelled)] {
+			for _, r := range runes {
+				if n := len(word); n == 0 || word[n-1] != r || r == '*' {
+					word = append(word, r)
+				}
+			}
+			words = append(words, slices.Clone(word))
+		}
+
+		for k := len(words) - 1; k >= profanityMinSpelled-1; k-- {
+			if entry, ok := f.matchNormalized(words[k]); ok {
+				start, end := letters[i].start, letters[i+k].end
+				matches = append(matches, ProfanityMatch{Text: text[start:end], Entry: entry, Start: start, End: end})
+				i += k
+				break
+			}
+		}
+	}
+	return matches
+}
+
+// matchLayout проверяет слово, набранное латиницей, как набранное в английской раскладке вместо русской,
+// и возвращает совпавшую запись и совпавшую часть слова. Завершающие знаки препинания пробуются
+// и как буквы раскладки ("," — «б»), и как пунктуация.
+func (f *ProfanityFilter) matchLayout(field string) (string, string, bool) {
+	if layoutSkipPattern.MatchString(field) || strings.IndexFunc(field, func(r rune) bool {
+		return r >= utf8.RuneSelf
+	}) >= 0 || strings.IndexFunc(field, unicode.IsLetter) < 0 {
+		return "", "", false
+	}
+	for _, candidate := range []string{field, strings.TrimRight(field, ".,!?")} {
+		for _, token := range profanityTokens(ConvertLayoutToRu(candidate)) {
+			if entry, ok := f.match(token.text); ok {
+				return entry, candidate, true
+			}
+		}
+	}
+	return "", "", false
+}
+
+// profanityToken — слово текста и его байтовые границы.
+type profanityToken struct {
+	text       string
+	start, end int
+	letters    []profanityToken // буквы слова, написанного по буквам ("д у р а к")
+}
+
+// isProfanityRune сообщает, может ли символ входить в слово: буквы, цифры, диакритика
+// и знаки, которыми подменяют буквы.
+func isProfanityRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r) || strings.ContainsRune("*@$|", r)
+}
+
+// profanityTokens разбивает текст на слова. Буквы, разделенные одиночными пробелами, точками
+// или дефисами ("д у р а к"), объединяются в одно слово по буквам; невидимые символы внутри слов
+// относятся к слову.
+func profanityTokens(text string) []profanityToken {
+	var tokens []profanityToken
+	start := -1
+	for i, r := range text {
+		switch {
+		case isProfanityRune(r) || (start >= 0 && IsInvisible(r)):
+			if start < 0 {
+				start = i
+			}
+		case start >= 0:
+			tokens = append(tokens, profanityToken{text: text[start:i], start: start, end: i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, profanityToken{text: text[start:], start: start, end: len(text)})
+	}
+
+	// Одиночные буквы, разделенные одиночными разделителями, объединяются в слово по буквам
+	var merged []profanityToken
+	for i := 0; i < len(tokens); {
+		j := i
+		for j+1 < len(tokens) && utf8.RuneCountInString(tokens[j].text) == 1 && utf8.RuneCountInString(tokens[j+1].text) == 1 &&
+			tokens[j+1].start-tokens[j].end == 1 && strings.IndexByte(profanitySeparators, text[tokens[j].end]) >= 0 {
+			j++
+		}
+		if j-i+1 < profanityMinSpelled {
+			merged = append(merged, tokens[i])
+			i++
+			continue
+		}
+		merged = append(merged, profanityToken{text: text[tokens[i].start:tokens[j].end], start: tokens[i].start, end: tokens[j].end, letters: tokens[i : j+1]})
+		i = j + 1
+	}
+	return merged
+}
+
+// profanityFields возвращает слова текста, разделенные пробельными символами.
+func profanityFields(text string) []profanityToken {
+	var fields []profanityToken
+	for _, loc := range layoutTokenPattern.FindAllStringIndex(text, -1) {
+		fields = append(fields, profanityToken{text: text[loc[0]:loc[1]], start: loc[0], end: loc[1]})
+	}
+	return fields
+}
@@ -0,0 +1,123 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// newTestProfanityFilter возвращает фильтр с небольшим тестовым словарем.
+func newTestProfanityFilter() *ProfanityFilter {
+	f := NewProfanityFilter("дурак", "идиот", "stupid")
+	f.AddRoots("лох")
+	f.AddExceptions("лохматый")
+	return f
+}
+
+func TestProfanityFilterFind(t *testing.T) {
+	f := newTestProfanityFilter()
+	tests := []struct {
+		name string
+		text string
+		want []string // найденные слова в исходном написании
+	}{
+		{"Простое слово", "ты дурак!", []string{"дурак"}},
+		{"Регистр", "ДУРАК", []string{"ДУРАК"}},
+		{"Словоформы", "дураки, дураками, идиотов", []string{"дураки", "дураками", "идиотов"}},
+		{"Другие слова с тем же началом", "дуракаваляние и придурок", nil},
+		{"Латинские двойники", "дурaк ДУPAK", []string{"дурaк", "ДУPAK"}},
+		{"Leetspeak", "дур@к uдuот", []string{"дур@к", "uдuот"}},
+		{"Повторы букв", "дуууурааак", []string{"дуууурааак"}},
+		{"Звездочка вместо буквы", "д*рак", []string{"д*рак"}},
+		{"Слишком много звездочек", "***к", nil},
+		{"Выделение звездочками", "*дурак*", []string{"*дурак*"}},
+		{"По буквам", "ну ты д у р а к, и.д.и.о.т", []string{"д у р а к", "и.д.и.о.т"}},
+		{"По буквам среди других букв", "а б д-у-р-а-к в", []string{"д-у-р-а-к"}},
+		{"Невидимые символы", "дур​ак", []string{"дур​ак"}},
+		{"Английская раскладка", "ты lehfr, а он - blbjn", []string{"lehfr", "blbjn"}},
+		{"Английское слово", "so stupid", []string{"stupid"}},
+		{"Полноширинные буквы", "so \uff53\uff54\uff55\uff50\uff49\uff44", []string{"\uff53\uff54\uff55\uff50\uff49\uff44"}},
+		{"Корень", "лох, лохушка и лошадь", []string{"лох", "лохушка"}},
+		{"Исключения", "лохматый пёс, лохматые собаки", nil},
+		{"Чистый текст", "Хороший текст без нарушений, rules and words", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, m := range f.Find(tt.text) {
+				if tt.text[m.Start:m.End] != m.Text {
+					t.Errorf("Find(%q): границы %d-%d не соответствуют %q", tt.text, m.Start, m.End, m.Text)
+				}
+				got = append(got, m.Text)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Find(%q) = %q, ожидается %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProfanityFilterEntry(t *testing.T) {
+	f := newTestProfanityFilter()
+	got := f.Find("лохушки и идиоты")
+	want := []ProfanityMatch{
+		{Text: "лохушки", Entry: "лох", Start: 0, End: len("лохушки")},
+		{Text: "идиоты", Entry: "идиот", Start: len("лохушки и "), End: len("лохушки и идиоты")},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Find() = %+v, ожидается %+v", got, want)
+	}
+}
+
+func TestProfanityFilterMask(t *testing.T) {
+	f := newTestProfanityFilter()
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"ты дурак!", "ты д****!"},
+		{"Д у р а к, идиот.", "Д * * * *, и****."},
+		{"ты lehfr, понял?", "ты l****, понял?"},
+		{"всё хорошо", "всё хорошо"},
+	}
+	for _, tt := range tests {
+		if got := f.Mask(tt.text); got != tt.want {
+			t.Errorf("Mask(%q) = %q, ожидается %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestProfanityFilterCheck(t *testing.T) {
+	f := newTestProfanityFilter()
+	if err := f.Check("хороший комментарий"); err != nil {
+		t.Errorf("Check() = %v, ожидается nil", err)
+	}
+	if err := f.Check("сам ты д*рак"); !errors.Is(err, ErrProfanity) {
+		t.Errorf("Check() = %v, ожидается ErrProfanity", err)
+	}
+	if !f.Contains("ИДИОТ") || f.Contains("идиллия") {
+		t.Error("Contains() вернул неверный результат")
+	}
+	if NewProfanityFilter().Contains("дурак") {
+		t.Error("пустой фильтр не должен находить слова")
+	}
+}
+
+func TestProfanityFilterShortWord(t *testing.T) {
+	f := NewProfanityFilter("хуй")
+	for _, word := range []string{"хуй", "хуя", "хую", "хуем", "ХУИ"} {
+		if !f.Contains(word) {
+			t.Errorf("Contains(%q) = false, ожидается true", word)
+		}
+	}
+	for _, word := range []string{"ху", "худой", "хутор", "хурма"} {
+		if f.Contains(word) {
+			t.Errorf("Contains(%q) = true, ожидается false", word)
+		}
+	}
+}
@@ -0,0 +1,474 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"html"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// htmlTokenType — тип лексемы HTML.
+type htmlTokenType int
+
+const (
+	htmlTextToken htmlTokenType = iota
+	htmlStartTagToken
+	htmlEndTagToken
+	htmlCommentToken
+)
+
+// htmlAttr — атрибут тега со значением без HTML-сущностей.
+type htmlAttr struct {
+	Key string
+	Val string
+}
+
+// htmlToken — лексема HTML.
+type htmlToken struct {
+	Type htmlTokenType
+	// Name — имя тега в нижнем регистре
+	Name  string
+	Attrs []htmlAttr
+	// Data — текст (как в исходнике, без декодирования сущностей)
+	Data string
+	// SelfClosing — тег записан в виде <tag />
+	SelfClosing bool
+}
+
+// attr возвращает значение атрибута тега.
+func (t htmlToken) attr(key string) (string, bool) {
+	for _, a := range t.Attrs {
+		if a.Key == key {
+			return a.Val, true
+		}
+	}
+	return "", false
+}
+
+var (
+	// htmlRawTextTags — элементы, содержимое которых не разбирается на теги.
+	htmlRawTextTags = []string{"script", "style", "xmp", "iframe", "noembed", "noframes", "noscript", "textarea", "title", "plaintext"}
+
+	// htmlEscapableRawTextTags — raw-text элементы, содержимое которых выводится как текст:
+	// теги внутри них не разбираются, поэтому угловые скобки экранируются.
+	htmlEscapableRawTextTags = []string{"textarea", "title"}
+
+	// htmlDropContentTags — элементы, удаляемые вместе с содержимым.
+	htmlDropContentTags = []string{"script", "style", "iframe", "object", "embed", "noscript", "noembed", "noframes", "template", "xmp", "svg", "math", "applet", "frameset", "plaintext"}
+
+	// htmlVoidTags — элементы без закрывающего тега.
+	htmlVoidTags = []string{"area", "base", "br", "col", "embed", "hr", "img", "input", "link", "meta", "param", "source", "track", "wbr"}
+
+	// htmlURLAttrs — атрибуты, содержащие URL.
+	htmlURLAttrs = []string{"href", "src", "cite", "action", "formaction", "poster", "background", "longdesc", "xlink:href"}
+)
+
+// tokenizeHTML разбивает строку на лексемы.
+//
+// Разбор намеренно снисходителен и совместим с прежней регулярной реализацией SanitizeHTML:
+// тег начинается с '<', за которым следует любой символ, кроме '>' и пробела, и заканчивается
+// первым символом '>'. Комментарии <!-- ... --> заканчиваются последовательностью "-->",
+// объявления (<!...>, <?...>) и условные комментарии (<!--[if IE]>) — первым '>'. Незакрытый тег или комментарий в конце строки
+// отбрасывается вместе с остатком строки, как в браузерах. Содержимое script, style и подобных
+// элементов не разбирается на теги; в содержимом textarea и title угловые скобки экранируются.
+func tokenizeHTML(s string) []htmlToken {
+	var tokens []htmlToken
+	text := func(data string) {
+		if data != "" {
+			tokens = append(tokens, htmlToken{Type: htmlTextToken, Data: data})
+		}
+	}
+
+	for s != "" {
+		i := strings.IndexByte(s, '<')
+		if i < 0 {
+			text(s)
+			break
+		}
+		if i+1 == len(s) || s[i+1] == '>' || isHTMLSpace(s[i+1]) {
+			// "<>" и "<" перед пробелом или в конце строки — это текст
+			text(s[:i+1])
+			s = s[i+1:]
+			continue
+		}
+		text(s[:i])
+
+		if strings.HasPrefix(s[i:], "<!--") && !strings.HasPrefix(s[i:], "<!--[if") {
+			// Поиск с i+2 учитывает пустые комментарии "<!-->" и "<!--->"
+			end := strings.Index(s[i+2:], "-->")
+			if end < 0 {
+				break
+			}
+			tokens = append(tokens, htmlToken{Type: htmlCommentToken, Data: s[i+1 : i+2+end+2]})
+			s = s[i+2+end+3:]
+			continue
+		}
+
+		end := strings.IndexByte(s[i:], '>')
+		if end < 0 {
+			if c := s[i+1]; isASCIILetter(c) || c == '/' || c == '!' || c == '?' {
+				// Незакрытый тег отбрасывается: "<img src=x onerror=alert(1) " не должен попасть в текст
+				break
+			}
+			text(s[i : i+1])
+			s = s[i+1:]
+			continue
+		}
+		body := s[i+1 : i+end]
+		s = s[i+end+1:]
+
+		tok := parseHTMLTag(body)
+		tokens = append(tokens, tok)
+
+		// Содержимое raw-text элементов до закрывающего тега
+		if tok.Type == htmlStartTagToken && !tok.SelfClosing && slices.Contains(htmlRawTextTags, tok.Name) {
+			closeIdx := indexHTMLEndTag(s, tok.Name)
+			if closeIdx < 0 {
+				closeIdx = len(s)
+			}
+			content := s[:closeIdx]
+			if slices.Contains(htmlEscapableRawTextTags, tok.Name) {
+				content = strings.NewReplacer("<", "&lt;", ">", "&gt;").Replace(content)
+			}
+			text(content)
+			s = s[closeIdx:]
+		}
+	}
+	return tokens
+}
+
+// indexHTMLEndTag ищет закрывающий тег </name без учета регистра.
+func indexHTMLEndTag(s, name string) int {
+	lower := strings.ToLower(s)
+	offset := 0
+	for {
+		i := strings.Index(lower[offset:], "</"+name)
+		if i < 0 {
+			return -1
+		}
+		i += offset
+		after := i + 2 + len(name)
+		if after == len(s) || !isHTMLNameChar(rune(s[after])) {
+			return i
+		}
+		offset = after
+	}
+}
+
+// parseHTMLTag разбирает содержимое между '<' и '>'.
+func parseHTMLTag(body string) htmlToken {
+	switch body[0] {
+	case '!', '?':
+		return htmlToken{Type: htmlCommentToken, Data: body}
+	}
+
+	tok := htmlToken{Type: htmlStartTagToken}
+	if body[0] == '/' {
+		tok.Type = htmlEndTagToken
+		body = body[1:]
+	}
+
+	// Имя тега должно начинаться с латинской буквы, иначе это "мусорный" тег
+	n := 0
+	for n < len(body) && isHTMLNameChar(rune(body[n])) {
+		n++
+	}
+	if n == 0 || !isASCIILetter(body[0]) {
+		return htmlToken{Type: htmlCommentToken, Data: body}
+	}
+	tok.Name = strings.ToLower(body[:n])
+	body = body[n:]
+
+	if strings.HasSuffix(body, "/") {
+		tok.SelfClosing = true
+		body = body[:len(body)-1]
+	}
+	if tok.Type == htmlStartTagToken {
+		tok.Attrs = parseHTMLAttrs(body)
+	}
+	return tok
+}
+
+// parseHTMLAttrs разбирает список атрибутов тега.
+func parseHTMLAttrs(s string) []htmlAttr {
+	var attrs []htmlAttr
+	i := 0
+	for i < len(s) {
+		// Пропускаем разделители
+		for i < len(s) && (isHTMLSpace(s[i]) || s[i] == '/') {
+			i++
+		}
+		if i == len(s) {
+			break
+		}
+
+		// Имя атрибута
+		start := i
+		i++ // первый символ имени может быть любым, включая '='
+		for i < len(s) && !isHTMLSpace(s[i]) && s[i] != '/' && s[i] != '=' {
+			i++
+		}
+		key := strings.ToLower(s[start:i])
+
+		// Значение
+		for i < len(s) && isHTMLSpace(s[i]) {
+			i++
+		}
+		var val string
+		if i < len(s) && s[i] == '=' {
+			i++
+			for i < len(s) && isHTMLSpace(s[i]) {
+				i++
+			}
+			if i < len(s) && (s[i] == '"' || s[i] == '\'') {
+				quote := s[i]
+				i++
+				start = i
+				for i < len(s) && s[i] != quote {
+					i++
+				}
+				val = s[start:i]
+				if i < len(s) {
+					i++
+				}
+			} else {
+				start = i
+				for i < len(s) && !isHTMLSpace(s[i]) {
+					i++
+				}
+				val = s[start:i]
+			}
+		}
+
+		// Повторные атрибуты игнорируются, как в браузерах
+		if !slices.ContainsFunc(attrs, func(a htmlAttr) bool { return a.Key == key }) {
+			attrs = append(attrs, htmlAttr{Key: key, Val: html.UnescapeString(val)})
+		}
+	}
+	return attrs
+}
+
+// isHTMLSpace проверяет, является ли байт пробельным символом HTML.
+func isHTMLSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
+
+// isHTMLNameChar проверяет, допустим ли символ в имени тега.
+func isHTMLNameChar(r rune) bool {
+	return r < unicode.MaxASCII && (isASCIILetter(byte(r)) || (r >= '0' && r <= '9') || r == '-' || r == ':')
+}
+
+// isASCIILetter проверяет, является ли байт латинской буквой.
+func isASCIILetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// HTMLPolicy — политика очистки HTML на основе списков разрешений.
+// Все, что не разрешено явно, удаляется. Обработчики событий (on*), style и srcset
+// не разрешаются никогда, чтобы исключить выполнение скриптов.
+type HTMLPolicy struct {
+	// AllowedTags — разрешенные теги и их атрибуты
+	AllowedTags map[string][]string
+	// GlobalAttrs — атрибуты, разрешенные для всех разрешенных тегов
+	GlobalAttrs []string
+	// AllowedURLSchemes — допустимые схемы в атрибутах с URL (href, src и т. п.)
+	AllowedURLSchemes []string
+	// AllowRelativeURLs — разрешает URL без схемы
+	AllowRelativeURLs bool
+	// LinkRel — значения rel, которые добавляются к каждой ссылке <a href>
+	LinkRel []string
+}
+
+// StrictHTMLPolicy возвращает политику, которая не разрешает ни одного тега.
+func StrictHTMLPolicy() *HTMLPolicy {
+	return &HTMLPolicy{}
+}
+
+// UGCHTMLPolicy возвращает политику для пользовательского контента:
+// базовое форматирование, списки, цитаты, код, таблицы, ссылки и изображения.
+// Ссылкам принудительно добавляется rel="noopener noreferrer".
+func UGCHTMLPolicy() *HTMLPolicy {
+	return &HTMLPolicy{
+		AllowedTags: map[string][]string{
+			"a": {"href", "title", "target", "rel"},
+			"b": nil, "strong": nil, "i": nil, "em": nil, "u": nil, "s": nil, "del": nil, "ins": nil,
+			"sub": nil, "sup": nil, "small": nil, "mark": nil, "abbr": {"title"},
+			"p": nil, "br": nil, "hr": nil, "div": nil, "span": nil,
+			"h1": nil, "h2": nil, "h3": nil, "h4": nil, "h5": nil, "h6": nil,
+			"ul": nil, "ol": {"start"}, "li": nil, "dl": nil, "dt": nil, "dd": nil,
+			"blockquote": {"cite"}, "q": {"cite"}, "pre": nil, "code": nil,
+			"table": nil, "thead": nil, "tbody": nil, "tfoot": nil, "tr": nil,
+			"th": {"colspan", "rowspan"}, "td": {"colspan", "rowspan"}, "caption": nil,
+			"img": {"src", "alt", "title", "width", "height"},
+		},
+		GlobalAttrs:       []string{"lang", "dir"},
+		AllowedURLSchemes: []string{"http", "https", "mailto"},
+		AllowRelativeURLs: true,
+		LinkRel:           []string{"noopener", "noreferrer"},
+	}
+}
+
+// Sanitize возвращает безопасный HTML: разрешенные теги и атрибуты сохраняются,
+// остальные теги удаляются (опасные — вместе с содержимым), текст экранируется,
+// незакрытые теги закрываются.
+func (p *HTMLPolicy) Sanitize(s string) string {
+	var b strings.Builder
+	var open []string // стек открытых разрешенных тегов
+
+	walkHTML(s, func(tok htmlToken) bool {
+		switch tok.Type {
+		case htmlTextToken:
+			b.WriteString(html.EscapeString(html.UnescapeString(tok.Data)))
+		case htmlStartTagToken:
+			attrs, ok := p.AllowedTags[tok.Name]
+			if !ok {
+				break
+			}
+			b.WriteByte('<')
+			b.WriteString(tok.Name)
+			for _, a := range p.filterAttrs(tok, attrs) {
+				b.WriteByte(' ')
+				b.WriteString(a.Key)
+				b.WriteString(`="`)
+				b.WriteString(html.EscapeString(a.Val))
+				b.WriteByte('"')
+			}
+			b.WriteByte('>')
+			switch {
+			case slices.Contains(htmlVoidTags, tok.Name):
+			case tok.SelfClosing:
+				b.WriteString("</" + tok.Name + ">")
+			default:
+				open = append(open, tok.Name)
+			}
+		case htmlEndTagToken:
+			// Закрываем тег, только если он открыт; вложенные незакрытые закрываются автоматически
+			i := len(open) - 1
+			for i >= 0 && open[i] != tok.Name {
+				i--
+			}
+			if i < 0 {
+				break
+			}
+			for len(open) > i {
+				b.WriteString("</" + open[len(open)-1] + ">")
+				open = open[:len(open)-1]
+			}
+		}
+		return true
+	})
+
+	for len(open) > 0 {
+		b.WriteString("</" + open[len(open)-1] + ">")
+		open = open[:len(open)-1]
+	}
+	return b.String()
+}
+
+// StripHTMLTags удаляет все теги, комментарии и содержимое опасных элементов (script, style и т. п.),
+// возвращая текст как есть, без декодирования HTML-сущностей и нормализации пробелов.
+func StripHTMLTags(s string) string {
+	var b strings.Builder
+	walkHTML(s, func(tok htmlToken) bool {
+		if tok.Type == htmlTextToken {
+			b.WriteString(tok.Data)
+		}
+		return true
+	})
+	return b.String()
+}
+
+// walkHTML перебирает лексемы без комментариев и опасных элементов.
+// Перебор прекращается, если fn возвращает false.
+func walkHTML(s string, fn func(htmlToken) bool) {
+	var drop []string // стек удаляемых элементов
+	for _, tok := range tokenizeHTML(s) {
+		if len(drop) > 0 {
+			switch {
+			case tok.Type == htmlStartTagToken && tok.Name == drop[len(drop)-1] && !tok.SelfClosing:
+				drop = append(drop, tok.Name)
+			case tok.Type == htmlEndTagToken && tok.Name == drop[len(drop)-1]:
+				drop = drop[:len(drop)-1]
+			}
+			continue
+		}
+
+		switch tok.Type {
+		case htmlCommentToken:
+			continue
+		case htmlStartTagToken:
+			if slices.Contains(htmlDropContentTags, tok.Name) {
+				if !tok.SelfClosing && !slices.Contains(htmlVoidTags, tok.Name) {
+					drop = append(drop, tok.Name)
+				}
+				continue
+			}
+		case htmlEndTagToken:
+			if slices.Contains(htmlDropContentTags, tok.Name) {
+				continue
+			}
+		}
+		if !fn(tok) {
+			return
+		}
+	}
+}
+
+// filterAttrs оставляет только разрешенные атрибуты с безопасными значениями.
+func (p *HTMLPolicy) filterAttrs(tok htmlToken, allowed []string) []htmlAttr {
+	var attrs []htmlAttr
+	for _, a := range tok.Attrs {
+		if strings.HasPrefix(a.Key, "on") || a.Key == "style" || a.Key == "srcset" {
+			continue
+		}
+		if !slices.Contains(allowed, a.Key) && !slices.Contains(p.GlobalAttrs, a.Key) {
+			continue
+		}
+		if slices.Contains(htmlURLAttrs, a.Key) {
+			if !p.isAllowedURL(a.Val) {
+				continue
+			}
+			a.Val = strings.TrimSpace(a.Val)
+		}
+		if a.Key == "rel" {
+			continue // формируется ниже
+		}
+		attrs = append(attrs, a)
+	}
+
+	// Значение rel: исходное (если разрешено) плюс обязательные значения политики
+	var rel []string
+	if v, ok := tok.attr("rel"); ok && slices.Contains(allowed, "rel") {
+		rel = strings.Fields(strings.ToLower(v))
+	}
+	if tok.Name == "a" && slices.ContainsFunc(attrs, func(a htmlAttr) bool { return a.Key == "href" }) {
+		rel = append(rel, p.LinkRel...)
+	}
+	if rel = Unique(rel); len(rel) > 0 {
+		attrs = append(attrs, htmlAttr{Key: "rel", Val: strings.Join(rel, " ")})
+	}
+	return attrs
+}
+
+// isAllowedURL проверяет схему URL по политике.
+func (p *HTMLPolicy) isAllowedURL(raw string) bool {
+	// Браузеры игнорируют пробелы и управляющие символы внутри схемы ("java\tscript:")
+	cleaned := strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, raw)
+
+	colon := strings.IndexByte(cleaned, ':')
+	if colon < 0 || strings.ContainsAny(cleaned[:colon], "/?#") {
+		return p.AllowRelativeURLs
+	}
+	scheme := strings.ToLower(cleaned[:colon])
+	return slices.ContainsFunc(p.AllowedURLSchemes, func(s string) bool { return strings.EqualFold(s, scheme) })
+}
@@ -0,0 +1,113 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"testing"
+)
+
+func TestStripHTMLTags(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"1", `<p>Hello, <b>World</b>!</p>`, "Hello, World!"},
+		{"2", `a<script src="x.js"></script>b`, "ab"},
+		{"3", `a<SCRIPT type="text/javascript">alert("<b>x</b>")</SCRIPT >b`, "ab"},
+		{"4", `a<script>var s = "</scripts>";</script>b`, "ab"},
+		{"5", `a<style media="all">p { color: red }</STYLE>b`, "ab"},
+		{"6", `a<object><object>x</object>y</object>b`, "ab"},
+		{"7", `a<svg><script>alert(1)</script><text>t</text></svg>b`, "ab"},
+		{"8", `a<img src=x onerror=alert(1)>b`, "ab"},
+		{"9", `a<script>alert(1)`, "a"},
+		{"10", `1 < 2 и 3 <> 4`, "1 < 2 и 3 <> 4"},
+		{"11", `a<!-- comment -->b`, "ab"},
+		{"12", `&lt;b&gt; &amp;`, "&lt;b&gt; &amp;"},
+		{"13", `<title>T</title><textarea><b>x</b></textarea>`, "T&lt;b&gt;x&lt;/b&gt;"},
+		{"14", `a<br/>b<embed src="x"/>c`, "abc"},
+		{"15", `<textarea><script>x</script></textarea>after`, "&lt;script&gt;x&lt;/script&gt;after"},
+		{"16", `a<img src=x onerror=alert(1) `, "a"},
+		{"17", `a<!-- c > d -->b<!-->c<!-- незакрытый`, "abc"},
+		{"18", `1 <2`, "1 <2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StripHTMLTags(tt.s); got != tt.want {
+				t.Errorf("StripHTMLTags() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHTMLPolicySanitize(t *testing.T) {
+	policy := UGCHTMLPolicy()
+
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"Разрешенные теги", `<p>Hello, <b>World</b>!</p>`, `<p>Hello, <b>World</b>!</p>`},
+		{"Регистр тегов", `<P>Hi<BR></P>`, `<p>Hi<br></p>`},
+		{"Обработчики событий", `<p onclick="alert(1)" class="x" style="color:red">x</p>`, `<p>x</p>`},
+		{"Скрипт с атрибутами", `a<script src="//evil/x.js"></script>b`, `ab`},
+		{"Скрипт в верхнем регистре", `a<SCRIPT type=x>alert(1)</SCRIPT>b`, `ab`},
+		{"Изображение с onerror", `<img src="/a.png" onerror="alert(1)" alt="A">`, `<img src="/a.png" alt="A">`},
+		{"javascript: в ссылке", `<a href="javascript:alert(1)">x</a>`, `<a>x</a>`},
+		{"Обфусцированная схема", "<a href=\"java\tscript:alert(1)\">x</a>", `<a>x</a>`},
+		{"Схема в сущностях", `<a href="&#106;avascript:alert(1)">x</a>`, `<a>x</a>`},
+		{"data: в изображении", `<img src="data:image/svg+xml;base64,AAAA">`, `<img>`},
+		{"Ссылка получает rel", `<a href="https://example.com" target="_blank">x</a>`, `<a href="https://example.com" target="_blank" rel="noopener noreferrer">x</a>`},
+		{"Слияние rel", `<a href="/p" rel="nofollow NOOPENER">x</a>`, `<a href="/p" rel="nofollow noopener noreferrer">x</a>`},
+		{"Запрещенные теги удаляются", `<div><iframe src="x"></iframe><form action="/x"><input></form>ok</div>`, `<div>ok</div>`},
+		{"Текст экранируется", `1 < 2 & "q" <> 3`, `1 &lt; 2 &amp; &#34;q&#34; &lt;&gt; 3`},
+		{"Сущности сохраняются", `&lt;b&gt; &amp;amp; &copy;`, `&lt;b&gt; &amp;amp; ©`},
+		{"Незакрытые теги", `<ul><li><b>x</ul>y`, `<ul><li><b>x</b></li></ul>y`},
+		{"Лишние закрывающие теги", `</p>x</b>`, `x`},
+		{"Самозакрывающийся тег", `<span/>x`, `<span></span>x`},
+		{"Экранирование атрибутов", `<a title='a"b&lt;'>x</a>`, `<a title="a&#34;b&lt;">x</a>`},
+		{"Глобальные атрибуты", `<p lang="ru" dir="ltr" id="x">x</p>`, `<p lang="ru" dir="ltr">x</p>`},
+		{"Комментарии", `a<!-- <script>alert(1)</script> -->b`, `ab`},
+		{"Мусорные теги", `<<<><<script src=http://fake-evil.ru/test.js>`, ``},
+		{"Незакрытый тег", `a<img src=x onerror=alert(1) `, `a`},
+		{"Содержимое textarea", `<textarea><script>x</script></textarea>after`, `&lt;script&gt;x&lt;/script&gt;after`},
+		{"Знак > в комментарии", `a<!-- c > d -->b`, `ab`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := policy.Sanitize(tt.s); got != tt.want {
+				t.Errorf("Sanitize() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHTMLPolicyCustom(t *testing.T) {
+	policy := &HTMLPolicy{
+		AllowedTags:       map[string][]string{"a": {"href"}},
+		AllowedURLSchemes: []string{"HTTPS"},
+	}
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{`<a href="https://x.ru">x</a>`, `<a href="https://x.ru">x</a>`},
+		{`<a href="http://x.ru">x</a>`, `<a>x</a>`},
+		{`<a href="/relative">x</a>`, `<a>x</a>`},
+		{`<a href="  https://x.ru  " rel="nofollow">x</a>`, `<a href="https://x.ru">x</a>`},
+		{`<p><b>x</b></p>`, `x`},
+	}
+	for _, tt := range tests {
+		if got := policy.Sanitize(tt.s); got != tt.want {
+			t.Errorf("Sanitize(%q) = %q, want %q", tt.s, got, tt.want)
+		}
+	}
+
+	if got := StrictHTMLPolicy().Sanitize(`<b>x</b> & y`); got != `x &amp; y` {
+		t.Errorf("StrictHTMLPolicy().Sanitize() = %q", got)
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// searchStopWords — служебные слова русского и английского языков (списки Snowball),
+// не учитываемые при поиске. Слова записаны в нормализованном виде (см. NormalizeSearchText).
+var searchStopWords = makeStopWords(
+	// Русский
+	"и в во не что он на я с со как а то все она так его но да ты к у же вы за бы по только ее мне было вот от меня еще нет о из ему теперь когда даже ну вдруг ли если уже или ни быть был него до вас нибудь опять уж вам ведь там потом себя ничего ей может они тут где есть надо ней для мы тебя их чем была сам чтоб без будто чего раз тоже себе под будет ж тогда кто этот того потому этого какой совсем ним здесь этом один почти мой тем чтобы нее сейчас были куда зачем всех никогда можно при наконец два об другой хоть после над больше тот через эти нас про всего них какая много разве три эту моя впрочем хорошо свою этой перед иногда лучше чуть том нельзя такой им более всегда конечно всю между",
+	// Английский
+	"a about above after again against all am an and any are aren't as at be because been before being below between both but by can't cannot could couldn't did didn't do does doesn't doing don't down during each few for from further had hadn't has hasn't have haven't having he he'd he'll he's her here here's hers herself him himself his how how's i i'd i'll i'm i've if in into is isn't it it's its itself let's me more most mustn't my myself no nor not of off on once only or other ought our ours ourselves out over own same shan't she she'd she'll she's should shouldn't so some such than that that's the their theirs them themselves then there there's these they they'd they'll they're they've this those through to too under until up very was wasn't we we'd we'll we're we've were weren't what what's when when's where where's which while who who's whom why why's with won't would wouldn't you you'd you'll you're you've your yours yourself yourselves",
+)
+
+// makeStopWords строит множество слов из списков, разделенных пробелами.
+func makeStopWords(lists ...string) map[string]struct{} {
+	set := make(map[string]struct{})
+	for _, list := range lists {
+		for _, word := range strings.Fields(list) {
+			set[word] = struct{}{}
+		}
+	}
+	return set
+}
+
+// isSearchApostrophe сообщает, является ли руна апострофом.
+func isSearchApostrophe(r rune) bool {
+	return r == '\'' || r == '’' || r == 'ʼ'
+}
+
+// NormalizeSearchText нормализует текст для поиска: приводит к нижнему регистру, заменяет «ё» на «е»,
+// удаляет диакритику латиницы ("café" → "cafe"), заменяет знаки препинания и прочие символы пробелами
+// и схлопывает пробелы (см. ClearString). Апостроф внутри слова сохраняется: "don't".
+// Пример: "Ёжик, в ТУМАНЕ!" → "ежик в тумане".
+func NormalizeSearchText(s string) string {
+	runes := []rune(strings.ToLower(norm.NFC.String(s)))
+
+	var b strings.Builder
+	b.Grow(len(s))
+	for i, r := range runes {
+		switch {
+		case r == 'ё':
+			b.WriteRune('е')
+		case unicode.Is(unicode.Latin, r):
+			if latin, ok := slugLatinLetters[r]; ok {
+				b.WriteString(latin)
+				continue
+			}
+			for _, d := range norm.NFD.String(string(r)) {
+				if !unicode.Is(unicode.Mn, d) {
+					b.WriteRune(d)
+				}
+			}
+		case unicode.IsLetter(r), unicode.IsDigit(r):
+			b.WriteRune(r)
+		case unicode.Is(unicode.Mn, r):
+			// Отдельные диакритические знаки не разделяют слово
+		case isSearchApostrophe(r) && i > 0 && i < len(runes)-1 && unicode.IsLetter(runes[i-1]) && unicode.IsLetter(runes[i+1]):
+			b.WriteByte('\'')
+		default:
+			b.WriteByte(' ')
+		}
+	}
+	return ClearString(b.String())
+}
+
+// IsStopWord сообщает, является ли слово служебным (предлог, союз, местоимение и т.п.)
+// в русском или английском языке. Регистр и «ё» не учитываются.
+func IsStopWord(word string) bool {
+	_, ok := searchStopWords[NormalizeSearchText(word)]
+	return ok
+}
+
+// Stem возвращает основу слова: кириллические слова обрабатываются StemRussian,
+// латинские — StemEnglish, остальные (числа, слова других алфавитов) не изменяются.
+func Stem(word string) string {
+	switch {
+	case strings.IndexFunc(word, func(r rune) bool { return unicode.Is(unicode.Cyrillic, r) }) >= 0:
+		return StemRussian(word)
+	case strings.IndexFunc(word, func(r rune) bool { return unicode.Is(unicode.Latin, r) }) >= 0:
+		return StemEnglish(word)
+	}
+	return word
+}
+
+// SearchTokens разбивает текст на токены для индексации и поиска: текст нормализуется
+// (NormalizeSearchText), служебные слова удаляются, остальные слова сводятся к основе (Stem).
+// Пример: "Красивые книги о программировании" → ["красив", "книг", "программирован"].
+func SearchTokens(s string) []string {
+	var tokens []string
+	for _, word := range strings.Fields(NormalizeSearchText(s)) {
+		if _, ok := searchStopWords[word]; ok {
+			continue
+		}
+		tokens = append(tokens, Stem(word))
+	}
+	return tokens
+}
+
+// SearchMatch сообщает, содержит ли текст все значимые слова запроса с учетом словоформ:
+// SearchMatch("красивая книга", "Купил две красивые книги") → true.
+// Запрос без значимых слов не совпадает ни с чем.
+func SearchMatch(query, text string) bool {
+	queryTokens := SearchTokens(query)
+	if len(queryTokens) == 0 {
+		return false
+	}
+
+	textTokens := make(map[string]struct{})
+	for _, token := range SearchTokens(text) {
+		textTokens[token] = struct{}{}
+	}
+	for _, token := range queryTokens {
+		if _, ok := textTokens[token]; !ok {
+			return false
+		}
+	}
+	return true
+}
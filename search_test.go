@@ -0,0 +1,105 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeSearchText(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"Регистр и ё", "Ёжик, в ТУМАНЕ!", "ежик в тумане"},
+		{"Диакритика латиницы", "Café Straße Łódź", "cafe strasse lodz"},
+		{"Краткая й сохраняется", "Йошкар-Ола", "йошкар ола"},
+		{"Разложенная й", "йод", "йод"},
+		{"Апостроф внутри слова", "Don’t stop 'em", "don't stop em"},
+		{"Числа", "iPhone 15, цена: 99 990 ₽", "iphone 15 цена 99 990"},
+		{"Пробелы", "  \tмного\n\n  пробелов ", "много пробелов"},
+		{"Пустая строка", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizeSearchText(tt.input); got != tt.expected {
+				t.Errorf("NormalizeSearchText(%q) = %q; ожидается %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIsStopWord(t *testing.T) {
+	tests := []struct {
+		word     string
+		expected bool
+	}{
+		{"и", true},
+		{"Её", true},
+		{"the", true},
+		{"Don't", true},
+		{"книга", false},
+		{"well", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			if got := IsStopWord(tt.word); got != tt.expected {
+				t.Errorf("IsStopWord(%q) = %v; ожидается %v", tt.word, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSearchTokens(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{"Русский текст", "Красивые книги о программировании", []string{"красив", "книг", "программирован"}},
+		{"Английский текст", "The running dogs of the city", []string{"run", "dog", "citi"}},
+		{"Смешанный текст", "Курсы Go для начинающих: 2025", []string{"курс", "go", "начина", "2025"}},
+		{"Только служебные слова", "и в на", nil},
+		{"Пустая строка", "", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SearchTokens(tt.input); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("SearchTokens(%q) = %q; ожидается %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSearchMatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		text     string
+		expected bool
+	}{
+		{"Словоформы", "красивая книга", "Купил две красивые книги", true},
+		{"Ё и регистр", "ЁЖИК", "Ежики в тумане", true},
+		{"Английский", "connection", "Connected devices", true},
+		{"Не все слова", "красная книга", "Купил две красивые книги", false},
+		{"Служебные слова не учитываются", "книги для детей", "Детские книги и книги детям", true},
+		{"Пустой запрос", "и", "и так далее", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SearchMatch(tt.query, tt.text); got != tt.expected {
+				t.Errorf("SearchMatch(%q, %q) = %v; ожидается %v", tt.query, tt.text, got, tt.expected)
+			}
+		})
+	}
+}
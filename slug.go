@@ -0,0 +1,124 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+var (
+	// ErrEmptySlug возвращается, если после транслитерации и очистки строка стала пустой.
+	ErrEmptySlug = errors.New("helpers: строка не содержит символов, пригодных для slug")
+	// ErrSlugExhausted возвращается, если UniqueSlug не нашла свободный slug за отведенное число попыток.
+	ErrSlugExhausted = errors.New("helpers: не удалось подобрать уникальный slug")
+)
+
+// uniqueSlugMaxAttempts — максимальное число проверок при подборе уникального slug.
+const uniqueSlugMaxAttempts = 1000
+
+// slugLatinLetters — латинские буквы, которые не раскладываются на основу и диакритический знак.
+var slugLatinLetters = map[rune]string{
+	'ß': "ss", 'æ': "ae", 'œ': "oe", 'ø': "o", 'đ': "d", 'ð': "d", 'ł': "l", 'þ': "th", 'ı': "i", 'ħ': "h",
+}
+
+// Slugify преобразует строку в slug для URL: строчные латинские буквы, цифры и дефисы.
+// Кириллица транслитерируется (TranslitSimple), диакритика латиницы удаляется,
+// эмодзи и прочие символы отбрасываются, разделители схлопываются в один дефис.
+// При maxLength > 0 результат обрезается по границе слова.
+func Slugify(s string, maxLength int) string {
+	s = ReplaceEmojis(s, func(string) string { return " " })
+	return truncateSlug(slugWords(s), maxLength)
+}
+
+// slugWords транслитерирует строку и оставляет в ней только строчные латинские буквы и цифры,
+// разделяя слова дефисом.
+func slugWords(s string) string {
+	s = Transliterate(s, TranslitSimple)
+	s = norm.NFKD.String(s)
+
+	var b strings.Builder
+	b.Grow(len(s))
+	separator := false
+	for _, r := range strings.ToLower(s) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			if separator && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			separator = false
+			b.WriteRune(r)
+		case unicode.Is(unicode.Mn, r), r == '\'', r == '’':
+			// Диакритические знаки и апострофы не разделяют слово
+		default:
+			if latin, ok := slugLatinLetters[r]; ok {
+				if separator && b.Len() > 0 {
+					b.WriteByte('-')
+				}
+				separator = false
+				b.WriteString(latin)
+				continue
+			}
+			separator = true
+		}
+	}
+	return b.String()
+}
+
+// truncateSlug обрезает slug до maxLength байт по последнему дефису;
+// если дефиса нет, slug обрезается жестко.
+func truncateSlug(slug string, maxLength int) string {
+	if maxLength <= 0 || len(slug) <= maxLength {
+		return slug
+	}
+	cut := slug[:maxLength]
+	if slug[maxLength] != '-' {
+		if i := strings.LastIndexByte(cut, '-'); i > 0 {
+			cut = cut[:i]
+		}
+	}
+	return strings.Trim(cut, "-")
+}
+
+// UniqueSlug строит slug (см. Slugify) и подбирает уникальный вариант,
+// добавляя счетчик "-2", "-3" и т.д., пока exists сообщает, что slug занят.
+// Длина результата вместе со счетчиком не превышает maxLength.
+func UniqueSlug(s string, maxLength int, exists func(slug string) (bool, error)) (string, error) {
+	base := Slugify(s, maxLength)
+	if base == "" {
+		return "", ErrEmptySlug
+	}
+
+	slug := base
+	for n := 2; n < uniqueSlugMaxAttempts+2; n++ {
+		taken, err := exists(slug)
+		if err != nil {
+			return "", err
+		}
+		if !taken {
+			return slug, nil
+		}
+
+		suffix := "-" + strconv.Itoa(n)
+		prefix := base
+		if maxLength > 0 && len(prefix)+len(suffix) > maxLength {
+			// Для основы не осталось места: truncateSlug считает limit <= 0 отсутствием ограничения
+			if maxLength-len(suffix) < 1 {
+				return "", ErrSlugExhausted
+			}
+			prefix = truncateSlug(base, maxLength-len(suffix))
+		}
+		if prefix == "" {
+			return "", ErrSlugExhausted
+		}
+		slug = prefix + suffix
+	}
+	return "", ErrSlugExhausted
+}
@@ -0,0 +1,90 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		name      string
+		s         string
+		maxLength int
+		want      string
+	}{
+		{"Кириллица", "Отчёт за май 2025", 0, "otchet-za-may-2025"},
+		{"Диакритика", "Crème Brûlée à la française", 0, "creme-brulee-a-la-francaise"},
+		{"Особые буквы", "Straße Ærø Łódź", 0, "strasse-aero-lodz"},
+		{"Эмодзи", "Привет 😊 мир 🚀!", 0, "privet-mir"},
+		{"Разделители", "  --Hello,   World!!__ ", 0, "hello-world"},
+		{"Апострофы", "Don't stop, л'Окситан", 0, "dont-stop-loksitan"},
+		{"Лигатуры", "ﬁle №5", 0, "file-no5"},
+		{"По границе слова", "Съешь же ещё этих мягких булок", 20, "sesh-zhe-esche-etih"},
+		{"Граница совпадает", "abc def ghi", 7, "abc-def"},
+		{"Длинное слово", "Превысокомногорассмотрительствующий", 10, "prevysokom"},
+		{"Только символы", "!!! ??? 😊", 0, ""},
+		{"Пустая строка", "", 0, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Slugify(tt.s, tt.maxLength); got != tt.want {
+				t.Errorf("Slugify() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUniqueSlug(t *testing.T) {
+	taken := map[string]bool{"novosti": true, "novosti-2": true, "abc-def": true, "abc-2": true}
+	exists := func(slug string) (bool, error) {
+		return taken[slug], nil
+	}
+
+	tests := []struct {
+		name      string
+		s         string
+		maxLength int
+		want      string
+	}{
+		{"Свободный", "Статьи", 0, "stati"},
+		{"Счетчик", "Новости", 0, "novosti-3"},
+		{"Обрезка под счетчик", "abc def", 7, "abc-3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := UniqueSlug(tt.s, tt.maxLength, exists)
+			if err != nil {
+				t.Fatalf("UniqueSlug() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("UniqueSlug() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := UniqueSlug("😊", 0, exists); !errors.Is(err, ErrEmptySlug) {
+		t.Errorf("UniqueSlug() error = %v, want ErrEmptySlug", err)
+	}
+
+	always := func(string) (bool, error) { return true, nil }
+	if _, err := UniqueSlug("x", 0, always); !errors.Is(err, ErrSlugExhausted) {
+		t.Errorf("UniqueSlug() error = %v, want ErrSlugExhausted", err)
+	}
+
+	// Счетчик "-2" не помещается вместе с основой в maxLength = 2
+	takenAB := func(slug string) (bool, error) { return slug == "ab", nil }
+	if got, err := UniqueSlug("ab", 2, takenAB); !errors.Is(err, ErrSlugExhausted) {
+		t.Errorf("UniqueSlug() = %q, %v, want ErrSlugExhausted", got, err)
+	}
+
+	errDB := errors.New("db")
+	failing := func(string) (bool, error) { return false, errDB }
+	if _, err := UniqueSlug("x", 0, failing); !errors.Is(err, errDB) {
+		t.Errorf("UniqueSlug() error = %v, want ошибку проверки", err)
+	}
+}
@@ -0,0 +1,318 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// stemRegion возвращает байтовое смещение области после первой согласной, следующей за гласной,
+// начиная поиск с позиции from (области R1 и R2 алгоритмов Snowball).
+func stemRegion(word string, from int, isVowel func(rune) bool) int {
+	seenVowel := false
+	for i, r := range word[from:] {
+		if isVowel(r) {
+			seenVowel = true
+		} else if seenVowel {
+			return from + i + utf8.RuneLen(r)
+		}
+	}
+	return len(word)
+}
+
+// longestSuffix возвращает самое длинное окончание из списка, которым заканчивается слово.
+func longestSuffix(word string, suffixes []string) string {
+	best := ""
+	for _, suffix := range suffixes {
+		if len(suffix) > len(best) && strings.HasSuffix(word, suffix) {
+			best = suffix
+		}
+	}
+	return best
+}
+
+// Окончания русского стеммера Snowball. Окончания первых групп (…1) удаляются,
+// только если им предшествует «а» или «я».
+var (
+	ruPerfectiveGerund1 = []string{"в", "вши", "вшись"}
+	ruPerfectiveGerund2 = []string{"ив", "ивши", "ившись", "ыв", "ывши", "ывшись"}
+	ruAdjective         = []string{
+		"ее", "ие", "ые", "ое", "ими", "ыми", "ей", "ий", "ый", "ой", "ем", "им", "ым", "ом",
+		"его", "ого", "ему", "ому", "их", "ых", "ую", "юю", "ая", "яя", "ою", "ею",
+	}
+	ruParticiple1 = []string{"ем", "нн", "вш", "ющ", "щ"}
+	ruParticiple2 = []string{"ивш", "ывш", "ующ"}
+	ruReflexive   = []string{"ся", "сь"}
+	ruVerb1       = []string{"ла", "на", "ете", "йте", "ли", "й", "л", "ем", "н", "ло", "но", "ет", "ют", "ны", "ть", "ешь", "нно"}
+	ruVerb2       = []string{
+		"ила", "ыла", "ена", "ейте", "уйте", "ите", "или", "ыли", "ей", "уй", "ил", "ыл", "им", "ым", "ен",
+		"ило", "ыло", "ено", "ят", "ует", "уют", "ит", "ыт", "ены", "ить", "ыть", "ишь", "ую", "ю",
+	}
+	ruNounEndings = []string{
+		"а", "ев", "ов", "ие", "ье", "е", "иями", "ями", "ами", "еи", "ии", "и", "ией", "ей", "ой", "ий", "й",
+		"иям", "ям", "ием", "ем", "ам", "ом", "о", "у", "ах", "иях", "ях", "ы", "ь", "ию", "ью", "ю", "ия", "ья", "я",
+	}
+	ruDerivational = []string{"ост", "ость"}
+	ruTidyUp       = []string{"ейш", "ейше", "н", "ь"}
+)
+
+// isRuVowel сообщает, является ли руна гласной русского алфавита.
+func isRuVowel(r rune) bool {
+	return strings.ContainsRune("аеиоуыэюя", r)
+}
+
+// ruEnding ищет в конце области самое длинное окончание из двух групп; окончание первой группы
+// подходит, только если ему предшествует «а» или «я» внутри области.
+func ruEnding(region string, group1, group2 []string) (string, bool) {
+	suffix1, suffix2 := longestSuffix(region, group1), longestSuffix(region, group2)
+	if suffix2 != "" && len(suffix2) >= len(suffix1) {
+		return suffix2, true
+	}
+	if suffix1 == "" {
+		return "", false
+	}
+	rest := region[:len(region)-len(suffix1)]
+	if strings.HasSuffix(rest, "а") || strings.HasSuffix(rest, "я") {
+		return suffix1, true
+	}
+	return "", false
+}
+
+// StemRussian возвращает основу русского слова по алгоритму Snowball (Портера):
+// "красивая" → "красив", "книги" → "книг". Слово приводится к нижнему регистру, «ё» заменяется на «е».
+func StemRussian(word string) string {
+	word = strings.ReplaceAll(strings.ToLower(word), "ё", "е")
+	rv := strings.IndexFunc(word, isRuVowel)
+	if rv < 0 {
+		return word
+	}
+	rv += utf8.RuneLen('а')
+	r2 := stemRegion(word, stemRegion(word, 0, isRuVowel), isRuVowel)
+
+	cut := func(suffix string) { word = word[:len(word)-len(suffix)] }
+
+	// Шаг 1: деепричастие, либо возвратная частица и прилагательное, глагол или существительное
+	if suffix, ok := ruEnding(word[rv:], ruPerfectiveGerund1, ruPerfectiveGerund2); ok {
+		cut(suffix)
+	} else {
+		cut(longestSuffix(word[rv:], ruReflexive))
+		if suffix, ok := ruEnding(word[rv:], nil, ruAdjective); ok {
+			cut(suffix)
+			if suffix, ok := ruEnding(word[rv:], ruParticiple1, ruParticiple2); ok {
+				cut(suffix)
+			}
+		} else if suffix, ok := ruEnding(word[rv:], ruVerb1, ruVerb2); ok {
+			cut(suffix)
+		} else {
+			cut(longestSuffix(word[rv:], ruNounEndings))
+		}
+	}
+
+	// Шаг 2: окончание «и»
+	cut(longestSuffix(word[rv:], []string{"и"}))
+
+	// Шаг 3: словообразовательный суффикс в области R2
+	if r2 < len(word) {
+		cut(longestSuffix(word[r2:], ruDerivational))
+	}
+
+	// Шаг 4: превосходная степень, удвоенная «н» и мягкий знак
+	switch suffix := longestSuffix(word[rv:], ruTidyUp); suffix {
+	case "ейш", "ейше":
+		cut(suffix)
+		if strings.HasSuffix(word[rv:], "нн") {
+			cut("н")
+		}
+	case "н":
+		if strings.HasSuffix(word[rv:], "нн") {
+			cut("н")
+		}
+	case "ь":
+		cut(suffix)
+	}
+	return word
+}
+
+// Исключения английского стеммера Snowball (Porter2).
+var (
+	enStemExceptions = map[string]string{
+		"skis": "ski", "skies": "sky", "dying": "die", "lying": "lie", "tying": "tie",
+		"idly": "idl", "gently": "gentl", "ugly": "ugli", "early": "earli", "only": "onli", "singly": "singl",
+		"sky": "sky", "news": "news", "howe": "howe", "atlas": "atlas", "cosmos": "cosmos", "bias": "bias", "andes": "andes",
+	}
+	enStemInvariants = map[string]bool{
+		"inning": true, "outing": true, "canning": true, "herring": true,
+		"earring": true, "proceed": true, "exceed": true, "succeed": true,
+	}
+	enStep2Suffixes = map[string]string{
+		"tional": "tion", "enci": "ence", "anci": "ance", "abli": "able", "entli": "ent",
+		"izer": "ize", "ization": "ize", "ational": "ate", "ation": "ate", "ator": "ate",
+		"alism": "al", "aliti": "al", "alli": "al", "fulness": "ful", "ousli": "ous", "ousness": "ous",
+		"iveness": "ive", "iviti": "ive", "biliti": "ble", "bli": "ble", "ogi": "og", "fulli": "ful",
+		"lessli": "less", "li": "",
+	}
+	enStep3Suffixes = map[string]string{
+		"tional": "tion", "ational": "ate", "alize": "al", "icate": "ic", "iciti": "ic",
+		"ical": "ic", "ful": "", "ness": "", "ative": "",
+	}
+	enStep4Suffixes = []string{
+		"al", "ance", "ence", "er", "ic", "able", "ible", "ant", "ement", "ment",
+		"ent", "ism", "ate", "iti", "ous", "ive", "ize", "ion",
+	}
+)
+
+// isEnVowel сообщает, является ли руна гласной для английского стеммера; «Y» обозначает согласную «y».
+func isEnVowel(r rune) bool {
+	return strings.ContainsRune("aeiouy", r)
+}
+
+// enEndsWithShortSyllable сообщает, заканчивается ли слово коротким слогом:
+// согласная, гласная, согласная (кроме w, x, Y) либо гласная и согласная в начале слова.
+func enEndsWithShortSyllable(word string) bool {
+	n := len(word)
+	switch {
+	case n == 2:
+		return isEnVowel(rune(word[0])) && !isEnVowel(rune(word[1]))
+	case n > 2:
+		last := rune(word[n-1])
+		return !isEnVowel(rune(word[n-3])) && isEnVowel(rune(word[n-2])) && !isEnVowel(last) && !strings.ContainsRune("wxY", last)
+	}
+	return false
+}
+
+// mapSuffixKeys возвращает окончания из ключей таблицы замен.
+func mapSuffixKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+var (
+	enStep2Keys = mapSuffixKeys(enStep2Suffixes)
+	enStep3Keys = mapSuffixKeys(enStep3Suffixes)
+)
+
+// StemEnglish возвращает основу английского слова по алгоритму Snowball (Porter2):
+// "running" → "run", "generously" → "generous". Слова не из латинских букв не изменяются.
+func StemEnglish(word string) string {
+	word = strings.ToLower(word)
+	if len(word) <= 2 || strings.IndexFunc(word, func(r rune) bool { return (r < 'a' || r > 'z') && r != '\'' }) >= 0 {
+		return word
+	}
+	if stem, ok := enStemExceptions[word]; ok {
+		return stem
+	}
+
+	// Начальный апостроф удаляется, «y» в роли согласной обозначается как «Y»
+	word = strings.TrimPrefix(word, "'")
+	b := []byte(word)
+	for i := range b {
+		if b[i] == 'y' && (i == 0 || isEnVowel(rune(b[i-1]))) {
+			b[i] = 'Y'
+		}
+	}
+	word = string(b)
+
+	r1 := stemRegion(word, 0, isEnVowel)
+	for _, prefix := range []string{"gener", "commun", "arsen"} {
+		if strings.HasPrefix(word, prefix) {
+			r1 = len(prefix)
+		}
+	}
+	r2 := stemRegion(word, r1, isEnVowel)
+
+	stem := func(suffix string) string { return word[:len(word)-len(suffix)] }
+	inRegion := func(suffix string, region int) bool { return len(word)-len(suffix) >= region }
+
+	// Шаг 0: притяжательные окончания
+	word = stem(longestSuffix(word, []string{"'", "'s", "'s'"}))
+
+	// Шаг 1a: множественное число
+	switch suffix := longestSuffix(word, []string{"sses", "ied", "ies", "us", "ss", "s"}); suffix {
+	case "sses":
+		word = stem("es")
+	case "ied", "ies":
+		if len(word) > 4 {
+			word = stem("es")
+		} else {
+			word = stem("s")
+		}
+	case "s":
+		if strings.ContainsAny(word[:len(word)-2], "aeiouy") {
+			word = stem(suffix)
+		}
+	}
+	if enStemInvariants[word] {
+		return word
+	}
+
+	// Шаг 1b: прошедшее время и причастия
+	switch suffix := longestSuffix(word, []string{"eed", "eedly", "ed", "edly", "ing", "ingly"}); suffix {
+	case "eed", "eedly":
+		if inRegion(suffix, r1) {
+			word = stem(suffix) + "ee"
+		}
+	case "ed", "edly", "ing", "ingly":
+		if !strings.ContainsAny(stem(suffix), "aeiouy") {
+			break
+		}
+		word = stem(suffix)
+		switch {
+		case strings.HasSuffix(word, "at"), strings.HasSuffix(word, "bl"), strings.HasSuffix(word, "iz"):
+			word += "e"
+		case longestSuffix(word, []string{"bb", "dd", "ff", "gg", "mm", "nn", "pp", "rr", "tt"}) != "":
+			word = word[:len(word)-1]
+		case r1 >= len(word) && enEndsWithShortSyllable(word):
+			word += "e"
+		}
+	}
+
+	// Шаг 1c: конечная «y» после согласной
+	if n := len(word); n > 2 && (word[n-1] == 'y' || word[n-1] == 'Y') && !isEnVowel(rune(word[n-2])) {
+		word = word[:n-1] + "i"
+	}
+
+	// Шаг 2: суффиксы в области R1
+	if suffix := longestSuffix(word, enStep2Keys); suffix != "" && inRegion(suffix, r1) {
+		rest := stem(suffix)
+		switch {
+		case suffix == "ogi" && !strings.HasSuffix(rest, "l"):
+		case suffix == "li" && (rest == "" || !strings.ContainsRune("cdeghkmnrt", rune(rest[len(rest)-1]))):
+		default:
+			word = rest + enStep2Suffixes[suffix]
+		}
+	}
+
+	// Шаг 3: суффиксы в области R1, «ative» — в R2
+	if suffix := longestSuffix(word, enStep3Keys); suffix != "" && inRegion(suffix, r1) {
+		if suffix != "ative" || inRegion(suffix, r2) {
+			word = stem(suffix) + enStep3Suffixes[suffix]
+		}
+	}
+
+	// Шаг 4: суффиксы в области R2
+	if suffix := longestSuffix(word, enStep4Suffixes); suffix != "" && inRegion(suffix, r2) {
+		if suffix != "ion" || strings.HasSuffix(stem(suffix), "s") || strings.HasSuffix(stem(suffix), "t") {
+			word = stem(suffix)
+		}
+	}
+
+	// Шаг 5: конечные «e» и «l»
+	switch {
+	case strings.HasSuffix(word, "e"):
+		if inRegion("e", r2) || inRegion("e", r1) && !enEndsWithShortSyllable(stem("e")) {
+			word = stem("e")
+		}
+	case strings.HasSuffix(word, "ll"):
+		if inRegion("l", r2) {
+			word = stem("l")
+		}
+	}
+	return strings.ReplaceAll(word, "Y", "y")
+}
@@ -0,0 +1,93 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import "testing"
+
+func TestStemRussian(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"красивая", "красив"},
+		{"Красивые", "красив"},
+		{"книги", "книг"},
+		{"книгами", "книг"},
+		{"программирование", "программирован"},
+		{"читать", "чита"},
+		{"красивейший", "красив"},
+		{"важнейшие", "важн"},
+		{"вечности", "вечност"},
+		{"подвижность", "подвижн"},
+		{"бегающий", "бега"},
+		{"сделавшись", "сдела"},
+		{"длинный", "длин"},
+		{"улыбнулся", "улыбнул"},
+		{"ёжики", "ежик"},
+		{"москвой", "москв"},
+		{"он", "он"},
+		{"вв", "вв"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := StemRussian(tt.input); got != tt.expected {
+				t.Errorf("StemRussian(%q) = %q; ожидается %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestStemEnglish(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"consign", "consign"},
+		{"consigned", "consign"},
+		{"consignment", "consign"},
+		{"consistently", "consist"},
+		{"knackeries", "knackeri"},
+		{"knightly", "knight"},
+		{"Running", "run"},
+		{"hopping", "hop"},
+		{"hoping", "hope"},
+		{"generously", "generous"},
+		{"generate", "generat"},
+		{"communism", "communism"},
+		{"caresses", "caress"},
+		{"ponies", "poni"},
+		{"ties", "tie"},
+		{"gas", "gas"},
+		{"gaps", "gap"},
+		{"cried", "cri"},
+		{"happiness", "happi"},
+		{"relational", "relat"},
+		{"hopefulness", "hope"},
+		{"adoption", "adopt"},
+		{"agreed", "agre"},
+		{"feed", "feed"},
+		{"controll", "control"},
+		{"sayings", "say"},
+		{"yellow", "yellow"},
+		{"john's", "john"},
+		{"skies", "sky"},
+		{"dying", "die"},
+		{"news", "news"},
+		{"succeeding", "succeed"},
+		{"by", "by"},
+		{"café", "café"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := StemEnglish(tt.input); got != tt.expected {
+				t.Errorf("StemEnglish(%q) = %q; ожидается %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
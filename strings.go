@@ -15,22 +15,10 @@ import (
 )
 
 var (
-	clearStringPattern         = regexp.MustCompile(`\s+`)
-	clearTextareaPattern       = regexp.MustCompile(`(?:\r?\n){2,}|\r?\n(\s)*`)
-	filterLettersPattern       = regexp.MustCompile(`(?i)[^a-zа-яё]+`)
-	filterDigitsPattern        = regexp.MustCompile(`\D+`)
-	clearHtmlPageStylesPattern = regexp.MustCompile(`(?i)(?:<style>((?:.*?\r?\n?)*)</style>)+|(?:<script>((?:.*?\r?\n?)*)</script>)+|\s+(class="(.*?)")|\s+(style="(.*?)")`)
-	stripTagsPattern           = regexp.MustCompile(`<[^>]+>|<!--.*?-->`)
-	emojiPattern               = regexp.MustCompile(`[\x{1F600}-\x{1F64F}]|` + // Emoticons
-		`[\x{1F300}-\x{1F5FF}]|` + // Misc Symbols and Pictographs
-		`[\x{1F680}-\x{1F6FF}]|` + // Transport and Map Symbols
-		`[\x{2600}-\x{26FF}]|` + // Misc symbols
-		`[\x{2700}-\x{27BF}]|` + // Dingbats
-		`[\x{FE00}-\x{FE0F}]|` + // Variation Selectors
-		`[\x{1F900}-\x{1F9FF}]|` + // Supplemental Symbols and Pictographs
-		`[\x{1F1E6}-\x{1F1FF}]|` + // Flags (regional indicators)
-		`[\x{1FA70}-\x{1FAFF}]|` + // Symbols and Pictographs Extended-A
-		`[\x{200D}]`)
+	clearStringPattern   = regexp.MustCompile(`\s+`)
+	clearTextareaPattern = regexp.MustCompile(`(?:\r?\n){2,}|\r?\n(\s)*`)
+	filterLettersPattern = regexp.MustCompile(`(?i)[^a-zа-яё]+`)
+	filterDigitsPattern  = regexp.MustCompile(`\D+`)
 )
 
 // CutString обрезает строку до заданной длины.
@@ -82,11 +70,8 @@ func IsStringLengthInRange(s string, minLength int, maxLength int) bool {
 
 // SanitizeHTML очищает строку от HTML стилей, тегов и скриптов.
 func SanitizeHTML(s string) string {
-	// Удаление HTML стилей, скриптов
-	s = clearHtmlPageStylesPattern.ReplaceAllString(s, "")
-
-	// Удаление HTML тегов
-	s = stripTagsPattern.ReplaceAllString(s, "")
+	// Удаление HTML тегов, стилей, скриптов
+	s = StripHTMLTags(s)
 
 	// Очистка строки от лишних пробелов и переносов строк
 	return ClearString(s)
@@ -94,19 +79,16 @@ func SanitizeHTML(s string) string {
 
 // SanitizeHTMLWithTextarea очищает строку от HTML стилей, тегов и скриптов сохраня переносы.
 func SanitizeHTMLWithTextarea(s string) string {
-	// Удаление HTML стилей, скриптов
-	s = clearHtmlPageStylesPattern.ReplaceAllString(s, "")
-
-	// Удаление HTML тегов
-	s = stripTagsPattern.ReplaceAllString(s, "")
+	// Удаление HTML тегов, стилей, скриптов
+	s = StripHTMLTags(s)
 
 	// Очищает многострочный текст
 	return ClearTextarea(s)
 }
 
-// RemoveEmojis удаляет Emoji из строки.
+// RemoveEmojis удаляет Emoji из строки (см. StripEmojis).
 func RemoveEmojis(s string) string {
-	s = emojiPattern.ReplaceAllString(s, "")
+	s = StripEmojis(s)
 
 	// Очистка строки от лишних пробелов и переносов строк
 	return ClearString(s)
@@ -0,0 +1,184 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"math"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Скорость чтения вслух про себя, слов в минуту (Trauzettel-Klosinski et al., 2012; Brysbaert, 2019).
+const (
+	readingSpeedRu      = 184
+	readingSpeedEn      = 238
+	readingSpeedDefault = 200
+)
+
+var (
+	// textWordPattern — слово: буквы и цифры, возможно соединенные дефисом или апострофом ("что-то", "don't").
+	textWordPattern = regexp.MustCompile(`[\p{L}\p{N}]+(?:['’\-][\p{L}\p{N}]+)*`)
+	// textSentencePattern — конец предложения: знаки ".", "!", "?", "…", за которыми следует пробел или конец текста.
+	textSentencePattern = regexp.MustCompile(`[.!?…]+(?:["'»”)\]]*)(?:\s|$)`)
+	// textParagraphPattern — разделитель абзацев в тексте, полученном из HTML.
+	textParagraphPattern = regexp.MustCompile(`\n\s*\n`)
+)
+
+// TextStats — статистика текста.
+type TextStats struct {
+	Characters         int           // видимые символы (графемы) вместе с пробелами; пробелы подряд считаются одним
+	CharactersNoSpaces int           // видимые символы без пробелов
+	Words              int           // слова
+	Sentences          int           // предложения
+	Paragraphs         int           // абзацы
+	Syllables          int           // слоги
+	Language           string        // предполагаемый язык: "ru", "en" или пустая строка
+	ReadingTime        time.Duration // оценка времени чтения с точностью до секунды
+	// Readability — индекс удобочитаемости Флеша (для русского — в адаптации Оборневой):
+	// 100 — очень легкий текст, 0 и ниже — очень сложный
+	Readability float64
+	// GradeLevel — уровень Флеша–Кинкейда: число лет обучения, необходимое для понимания текста
+	GradeLevel float64
+}
+
+// AnalyzeText вычисляет статистику простого текста. Абзацами считаются непустые строки,
+// как в результате ClearTextarea. Язык определяется по DetectLanguage и влияет на подсчет
+// слогов, скорость чтения и формулы удобочитаемости.
+func AnalyzeText(text string) TextStats {
+	paragraphs := 0
+	for line := range strings.SplitSeq(text, "\n") {
+		if strings.TrimSpace(line) != "" {
+			paragraphs++
+		}
+	}
+	return analyzeText(text, paragraphs)
+}
+
+// AnalyzeHTML вычисляет статистику HTML-документа: разметка, скрипты и стили удаляются,
+// абзацами считаются блоки, разделенные пустой строкой в HTMLToText (абзацы, заголовки, списки).
+func AnalyzeHTML(html string) TextStats {
+	text := HTMLToText(html, HTMLToTextOptions{Links: HTMLLinkNone})
+	paragraphs := 0
+	for _, block := range textParagraphPattern.Split(text, -1) {
+		if strings.TrimSpace(block) != "" {
+			paragraphs++
+		}
+	}
+	return analyzeText(text, paragraphs)
+}
+
+// analyzeText вычисляет статистику текста с заданным числом абзацев.
+func analyzeText(text string, paragraphs int) TextStats {
+	stats := TextStats{Paragraphs: paragraphs, Language: DetectLanguage(text)}
+
+	for cluster := range GraphemeSeq(ClearString(text)) {
+		stats.Characters++
+		if strings.TrimSpace(cluster) != "" {
+			stats.CharactersNoSpaces++
+		}
+	}
+
+	words := textWordPattern.FindAllString(text, -1)
+	stats.Words = len(words)
+	if stats.Words == 0 {
+		return stats
+	}
+	for _, word := range words {
+		stats.Syllables += countSyllables(word, stats.Language)
+	}
+
+	// Последнее предложение может не заканчиваться знаком препинания
+	ends := textSentencePattern.FindAllStringIndex(text, -1)
+	stats.Sentences = len(ends)
+	if len(ends) == 0 || textWordPattern.MatchString(text[ends[len(ends)-1][1]:]) {
+		stats.Sentences++
+	}
+
+	speed := readingSpeedDefault
+	switch stats.Language {
+	case "ru":
+		speed = readingSpeedRu
+	case "en":
+		speed = readingSpeedEn
+	}
+	stats.ReadingTime = (time.Duration(stats.Words) * time.Minute / time.Duration(speed)).Round(time.Second)
+
+	wordsPerSentence := float64(stats.Words) / float64(stats.Sentences)
+	syllablesPerWord := float64(stats.Syllables) / float64(stats.Words)
+	if stats.Language == "ru" {
+		stats.Readability = 206.835 - 1.3*wordsPerSentence - 60.1*syllablesPerWord
+		stats.GradeLevel = 0.5*wordsPerSentence + 8.4*syllablesPerWord - 15.59
+	} else {
+		stats.Readability = 206.835 - 1.015*wordsPerSentence - 84.6*syllablesPerWord
+		stats.GradeLevel = 0.39*wordsPerSentence + 11.8*syllablesPerWord - 15.59
+	}
+	stats.Readability = RoundFloat(stats.Readability, 1)
+	stats.GradeLevel = RoundFloat(math.Max(stats.GradeLevel, 0), 1)
+	return stats
+}
+
+// countSyllables возвращает число слогов в слове: для кириллицы — число гласных,
+// для латиницы — число групп гласных без немой конечной "e". Слово без гласных считается одним слогом.
+func countSyllables(word, lang string) int {
+	word = strings.ToLower(word)
+	count := 0
+	if lang == "ru" || strings.ContainsFunc(word, func(r rune) bool { return unicode.Is(unicode.Cyrillic, r) }) {
+		for _, r := range word {
+			if isRuVowel(r) || r == 'ё' {
+				count++
+			}
+		}
+	} else {
+		vowel := false
+		for _, r := range word {
+			isVowel := strings.ContainsRune("aeiouy", r)
+			if isVowel && !vowel {
+				count++
+			}
+			vowel = isVowel
+		}
+		if count > 1 && strings.HasSuffix(word, "e") && !strings.HasSuffix(word, "le") {
+			count--
+		}
+	}
+	return max(count, 1)
+}
+
+// DetectLanguage определяет язык текста по распределению символов: "ru", если не менее 60% букв
+// кириллические, "en", если не менее 60% букв — латинские буквы английского алфавита.
+// Для текста без букв или с другим распределением возвращается пустая строка.
+func DetectLanguage(text string) string {
+	letters, cyrillic, english := 0, 0, 0
+	for _, r := range strings.ToLower(text) {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		letters++
+		switch {
+		case r >= 'а' && r <= 'я', r == 'ё':
+			cyrillic++
+		case r >= 'a' && r <= 'z':
+			english++
+		}
+	}
+	switch {
+	case letters == 0:
+		return ""
+	case float64(cyrillic) >= 0.6*float64(letters):
+		return "ru"
+	case float64(english) >= 0.6*float64(letters):
+		return "en"
+	}
+	return ""
+}
+
+// ReadingTime оценивает время чтения текста с точностью до секунды
+// по средней скорости чтения на языке текста (см. AnalyzeText).
+func ReadingTime(text string) time.Duration {
+	return AnalyzeText(text).ReadingTime
+}
@@ -0,0 +1,128 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAnalyzeText(t *testing.T) {
+	text := "Мама мыла раму. Папа читал газету!\nЧто-то пошло не так… Всё хорошо"
+	got := AnalyzeText(text)
+	want := TextStats{
+		Characters:         66,
+		CharactersNoSpaces: 55,
+		Words:              12,
+		Sentences:          4,
+		Paragraphs:         2,
+		Syllables:          23,
+		Language:           "ru",
+		ReadingTime:        4 * time.Second,
+		Readability:        87.7,
+		GradeLevel:         2,
+	}
+	if got != want {
+		t.Errorf("AnalyzeText() = %+v; ожидается %+v", got, want)
+	}
+}
+
+func TestAnalyzeTextEnglish(t *testing.T) {
+	got := AnalyzeText("The cat sat on the mat. It was a simple life")
+	if got.Language != "en" || got.Words != 11 || got.Sentences != 2 || got.Syllables != 12 {
+		t.Errorf("AnalyzeText() = %+v; ожидается en, 11 слов, 2 предложения, 12 слогов", got)
+	}
+	if got.Readability < 90 {
+		t.Errorf("Readability = %v; ожидается простой текст (> 90)", got.Readability)
+	}
+}
+
+func TestAnalyzeTextEmpty(t *testing.T) {
+	if got := AnalyzeText("  \n "); got != (TextStats{}) {
+		t.Errorf("AnalyzeText() = %+v; ожидается пустая статистика", got)
+	}
+}
+
+func TestAnalyzeHTML(t *testing.T) {
+	html := `<h1>Заголовок</h1><p>Первый <b>абзац</b>. <a href="https://example.com">Ссылка</a>.</p>` +
+		`<script>var x = "не текст";</script><ul><li>Один</li><li>Два</li></ul>`
+	got := AnalyzeHTML(html)
+	if got.Words != 6 || got.Paragraphs != 3 || got.Language != "ru" {
+		t.Errorf("AnalyzeHTML() = %+v; ожидается 6 слов, 3 абзаца, ru", got)
+	}
+}
+
+func TestDetectLanguage(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"Привет, мир!", "ru"},
+		{"Hello, world!", "en"},
+		{"Привет, world! Как дела?", "ru"},
+		{"Grüß Gott, straße", "en"},
+		{"Привет hello", ""},
+		{"日本語のテキスト", ""},
+		{"12345", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := DetectLanguage(tt.input); got != tt.expected {
+				t.Errorf("DetectLanguage(%q) = %q; ожидается %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestReadingTime(t *testing.T) {
+	tests := []struct {
+		name     string
+		words    int
+		word     string
+		expected time.Duration
+	}{
+		{"Русский текст", 184, "слово", time.Minute},
+		{"Английский текст", 476, "word", 2 * time.Minute},
+		{"Пустой текст", 0, "", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text := ""
+			for range tt.words {
+				text += tt.word + " "
+			}
+			if got := ReadingTime(text); got != tt.expected {
+				t.Errorf("ReadingTime() = %v; ожидается %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCountSyllables(t *testing.T) {
+	tests := []struct {
+		word     string
+		expected int
+	}{
+		{"молоко", 3},
+		{"ёжик", 2},
+		{"вдв", 1},
+		{"simple", 2},
+		{"make", 1},
+		{"beautiful", 3},
+		{"rhythm", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			if got := countSyllables(tt.word, ""); got != tt.expected {
+				t.Errorf("countSyllables(%q) = %d; ожидается %d", tt.word, got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// TranslitStandard — система транслитерации кириллицы латиницей.
+type TranslitStandard int
+
+const (
+	// TranslitGOST — ГОСТ 7.79-2000, система Б (обратимая, с диакритикой ` и буквосочетаниями).
+	TranslitGOST TranslitStandard = iota
+	// TranslitICAO — ICAO Doc 9303 (загранпаспорта).
+	TranslitICAO
+	// TranslitSimple — простая схема для URL и имен файлов (только латинские буквы).
+	TranslitSimple
+)
+
+// TranslitLanguage — язык исходного текста; влияет на передачу букв Г и И.
+type TranslitLanguage int
+
+const (
+	// TranslitRussian — русский язык.
+	TranslitRussian TranslitLanguage = iota
+	// TranslitUkrainian — украинский язык.
+	TranslitUkrainian
+	// TranslitBelarusian — белорусский язык.
+	TranslitBelarusian
+)
+
+// translitTables — таблицы строчных букв по стандартам.
+// Буквы украинского и белорусского алфавитов включены в общие таблицы.
+var translitTables = map[TranslitStandard]map[rune]string{
+	TranslitGOST: {
+		'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "yo", 'ж': "zh",
+		'з': "z", 'и': "i", 'й': "j", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o",
+		'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "x", 'ц': "cz",
+		'ч': "ch", 'ш': "sh", 'щ': "shh", 'ъ': "``", 'ы': "y`", 'ь': "`", 'э': "e`", 'ю': "yu",
+		'я': "ya", 'ґ': "g", 'є': "ye", 'і': "i", 'ї': "yi", 'ў': "u`",
+	},
+	TranslitICAO: {
+		'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e", 'ж': "zh",
+		'з': "z", 'и': "i", 'й': "i", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o",
+		'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts",
+		'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "ie", 'ы': "y", 'ь': "", 'э': "e", 'ю': "iu",
+		'я': "ia", 'ґ': "g", 'є': "ie", 'і': "i", 'ї': "i", 'ў': "u",
+	},
+	TranslitSimple: {
+		'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e", 'ж': "zh",
+		'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o",
+		'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "h", 'ц': "ts",
+		'ч': "ch", 'ш': "sh", 'щ': "sch", 'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu",
+		'я': "ya", 'ґ': "g", 'є': "ye", 'і': "i", 'ї': "yi", 'ў': "u",
+	},
+}
+
+// translitLanguageOverrides — отличия украинского и белорусского языков от русского.
+var translitLanguageOverrides = map[TranslitStandard]map[TranslitLanguage]map[rune]string{
+	TranslitGOST: {
+		TranslitUkrainian: {'г': "g`", 'и': "y`"},
+	},
+	TranslitICAO: {
+		TranslitUkrainian:  {'г': "h", 'и': "y"},
+		TranslitBelarusian: {'г': "h"},
+	},
+	TranslitSimple: {
+		TranslitUkrainian:  {'г': "h", 'и': "y"},
+		TranslitBelarusian: {'г': "h"},
+	},
+}
+
+// gostSoftC — буквы, перед которыми Ц по ГОСТ 7.79-2000 Б передается как "c", а не "cz".
+const gostSoftC = "еиыйіїє"
+
+// Transliterate транслитерирует русский текст латиницей по указанному стандарту.
+// Символы, отсутствующие в таблице, остаются без изменений.
+func Transliterate(s string, std TranslitStandard) string {
+	return TransliterateLang(s, std, TranslitRussian)
+}
+
+// TransliterateLang транслитерирует текст на русском, украинском или белорусском языке.
+func TransliterateLang(s string, std TranslitStandard, lang TranslitLanguage) string {
+	table, ok := translitTables[std]
+	if !ok {
+		return s
+	}
+	overrides := translitLanguageOverrides[std][lang]
+
+	runes := []rune(s)
+	var b strings.Builder
+	b.Grow(len(s))
+
+	for i, r := range runes {
+		lower := unicode.ToLower(r)
+		latin, ok := overrides[lower]
+		if !ok {
+			latin, ok = table[lower]
+		}
+		if !ok {
+			b.WriteRune(r)
+			continue
+		}
+
+		// ГОСТ Б: "c" перед е, и, ы, й
+		if std == TranslitGOST && lower == 'ц' && i+1 < len(runes) &&
+			strings.ContainsRune(gostSoftC, unicode.ToLower(runes[i+1])) {
+			latin = "c"
+		}
+
+		if r != lower {
+			latin = translitUpper(latin, runes, i)
+		}
+		b.WriteString(latin)
+	}
+	return b.String()
+}
+
+// translitUpper переводит латинскую замену заглавной буквы в верхний регистр:
+// целиком, если соседние буквы тоже заглавные ("ЩУКА" → "SHHUKA"), иначе только первую букву.
+func translitUpper(latin string, runes []rune, i int) string {
+	if latin == "" {
+		return latin
+	}
+	allCaps := (i+1 < len(runes) && unicode.IsUpper(runes[i+1])) ||
+		(i > 0 && unicode.IsUpper(runes[i-1]) && (i+1 == len(runes) || !unicode.IsLetter(runes[i+1])))
+	if allCaps {
+		return strings.ToUpper(latin)
+	}
+	return strings.ToUpper(latin[:1]) + latin[1:]
+}
+
+// gostReverse — обратная таблица ГОСТ 7.79-2000 Б для русского языка, от длинных сочетаний к коротким.
+var gostReverse = []struct {
+	latin    string
+	cyrillic rune
+}{
+	{"shh", 'щ'}, {"``", 'ъ'}, {"y`", 'ы'}, {"e`", 'э'}, {"yo", 'ё'}, {"zh", 'ж'},
+	{"cz", 'ц'}, {"ch", 'ч'}, {"sh", 'ш'}, {"yu", 'ю'}, {"ya", 'я'}, {"`", 'ь'},
+	{"a", 'а'}, {"b", 'б'}, {"v", 'в'}, {"g", 'г'}, {"d", 'д'}, {"e", 'е'}, {"z", 'з'},
+	{"i", 'и'}, {"j", 'й'}, {"k", 'к'}, {"l", 'л'}, {"m", 'м'}, {"n", 'н'}, {"o", 'о'},
+	{"p", 'п'}, {"r", 'р'}, {"s", 'с'}, {"t", 'т'}, {"u", 'у'}, {"f", 'ф'}, {"x", 'х'},
+	{"c", 'ц'},
+}
+
+// Detransliterate восстанавливает русский текст, транслитерированный по ГОСТ 7.79-2000 Б.
+// Латинские буквы, не используемые стандартом (h, q, w, y вне сочетаний), остаются без изменений.
+func Detransliterate(s string) string {
+	var b strings.Builder
+	b.Grow(len(s) * 2)
+
+	for i := 0; i < len(s); {
+		matched := false
+		for _, m := range gostReverse {
+			if !hasASCIIPrefixFold(s[i:], m.latin) {
+				continue
+			}
+			r := m.cyrillic
+			if s[i] >= 'A' && s[i] <= 'Z' {
+				r = unicode.ToUpper(r)
+			}
+			b.WriteRune(r)
+			i += len(m.latin)
+			matched = true
+			break
+		}
+		if !matched {
+			// Копируем символ целиком, включая многобайтовые
+			_, size := utf8.DecodeRuneInString(s[i:])
+			b.WriteString(s[i : i+size])
+			i += size
+		}
+	}
+	return b.String()
+}
+
+// hasASCIIPrefixFold проверяет, начинается ли s с prefix (строчные латинские буквы и знаки)
+// без учета регистра латинских букв. Остальные символы сравниваются побайтно, поэтому смещения
+// в s остаются корректными для любых символов Unicode (знак кельвина, «ẞ»).
+func hasASCIIPrefixFold(s, prefix string) bool {
+	if len(s) < len(prefix) {
+		return false
+	}
+	for i := 0; i < len(prefix); i++ {
+		c := s[i]
+		if c >= 'A' && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+		if c != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// NormalizeFilenameTranslit транслитерирует имя файла по указанному стандарту
+// и возвращает его URL-совместимое представление (см. NormalizeFilename).
+// Для имен файлов рекомендуется TranslitSimple: апострофы ГОСТ Б экранируются как %60.
+func NormalizeFilenameTranslit(filename string, std TranslitStandard) string {
+	return NormalizeFilename(Transliterate(filename, std))
+}
@@ -0,0 +1,107 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"testing"
+)
+
+func TestTransliterate(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		std  TranslitStandard
+		want string
+	}{
+		{"ГОСТ", "Съешь же ещё этих мягких французских булок", TranslitGOST, "S``esh` zhe eshhyo e`tix myagkix franczuzskix bulok"},
+		{"ГОСТ: Ц", "Цапля, цирк, лицо", TranslitGOST, "Czaplya, cirk, liczo"},
+		{"ГОСТ: заглавные", "ЩУКА и Щука", TranslitGOST, "SHHUKA i Shhuka"},
+		{"ICAO", "Щукин Юрий Эдуардович", TranslitICAO, "Shchukin Iurii Eduardovich"},
+		{"ICAO: Ъ и Ь", "Объём, Игорь", TranslitICAO, "Obieem, Igor"},
+		{"ICAO: заглавные", "ЮЛИЯ ХАРИТОНОВА", TranslitICAO, "IULIIA KHARITONOVA"},
+		{"Простая", "Отчёт за май", TranslitSimple, "Otchet za may"},
+		{"Простая: знаки", "Подъезд, щётка, Хабаровск", TranslitSimple, "Podezd, schetka, Habarovsk"},
+		{"Латиница не меняется", "Hello, мир! 123", TranslitSimple, "Hello, mir! 123"},
+		{"Пустая строка", "", TranslitGOST, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Transliterate(tt.s, tt.std); got != tt.want {
+				t.Errorf("Transliterate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTransliterateLang(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		std  TranslitStandard
+		lang TranslitLanguage
+		want string
+	}{
+		{"Украинский ГОСТ", "Київ, Ґанок, Гриць", TranslitGOST, TranslitUkrainian, "Ky`yiv, Ganok, G`ry`cz`"},
+		{"Украинский ICAO", "Григорій Євген", TranslitICAO, TranslitUkrainian, "Hryhorii Ievhen"},
+		{"Украинский простой", "Харків", TranslitSimple, TranslitUkrainian, "Harkiv"},
+		{"Белорусский ICAO", "Гомель, Ўладзімір", TranslitICAO, TranslitBelarusian, "Homel, Uladzimir"},
+		{"Белорусский ГОСТ", "Мінск", TranslitGOST, TranslitBelarusian, "Minsk"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TransliterateLang(tt.s, tt.std, tt.lang); got != tt.want {
+				t.Errorf("TransliterateLang() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetransliterate(t *testing.T) {
+	tests := []string{
+		"Съешь же ещё этих мягких французских булок, да выпей чаю",
+		"Цапля, цирк, лицо, Цюрих, ЦЕНТР",
+		"ЩУКА, Щука, Объявление, Эхо, Ёжик",
+		"Широкая электрификация южных губерний даст мощный толчок подъёму сельского хозяйства",
+	}
+	for _, s := range tests {
+		gost := Transliterate(s, TranslitGOST)
+		if got := Detransliterate(gost); got != s {
+			t.Errorf("Detransliterate(%q) = %q, want %q", gost, got, s)
+		}
+	}
+
+	// Буквы, не используемые стандартом, остаются без изменений
+	if got := Detransliterate("Privet, world!"); got != "Привет, wорлд!" {
+		t.Errorf("Detransliterate() = %q, want %q", got, "Привет, wорлд!")
+	}
+
+	// Символы, меняющие длину при смене регистра, не сдвигают смещения
+	for s, want := range map[string]string{
+		"\u212a\u212a\u212a": "\u212a\u212a\u212a",
+		"\u1e9ezh\u212aSh":   "\u1e9eж\u212aШ",
+	} {
+		if got := Detransliterate(s); got != want {
+			t.Errorf("Detransliterate(%q) = %q, want %q", s, got, want)
+		}
+	}
+}
+
+func TestNormalizeFilenameTranslit(t *testing.T) {
+	tests := []struct {
+		filename string
+		std      TranslitStandard
+		want     string
+	}{
+		{"Отчёт за май.pdf", TranslitSimple, "Otchet_za_may.pdf"},
+		{"Отчёт за май.pdf", TranslitICAO, "Otchet_za_mai.pdf"},
+		{"Объём.txt", TranslitGOST, "Ob%60%60yom.txt"},
+	}
+	for _, tt := range tests {
+		if got := NormalizeFilenameTranslit(tt.filename, tt.std); got != tt.want {
+			t.Errorf("NormalizeFilenameTranslit(%q) = %q, want %q", tt.filename, got, tt.want)
+		}
+	}
+}
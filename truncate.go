@@ -0,0 +1,88 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// TruncateOptions — параметры усечения строки.
+type TruncateOptions struct {
+	// Ellipsis — строка, добавляемая к усеченному тексту (например, "…"); входит в ограничение длины
+	Ellipsis string
+	// WordBoundary — усекать по границе слова; слово длиннее ограничения усекается посимвольно
+	WordBoundary bool
+}
+
+// truncateTrailing — символы, удаляемые в конце усеченного по границе слова текста.
+const truncateTrailing = ",;:-–—"
+
+// Truncate усекает строку до maxGraphemes видимых символов (расширенных графемных кластеров, UAX #29),
+// не разрывая флаги, символы с диакритикой и последовательности эмодзи.
+// Если строка короче ограничения, она возвращается без изменений.
+func Truncate(s string, maxGraphemes int, opts TruncateOptions) string {
+	return truncateBy(s, maxGraphemes, opts, func(string) int { return 1 })
+}
+
+// TruncateBytes усекает строку так, чтобы ее размер в UTF-8 не превышал maxBytes байт
+// (например, для столбцов VARCHAR с ограничением в байтах). Усечение выполняется по границам
+// графемных кластеров; некорректные последовательности UTF-8 предварительно удаляются,
+// поэтому результат всегда является корректной строкой UTF-8.
+func TruncateBytes(s string, maxBytes int, opts TruncateOptions) string {
+	return truncateBy(strings.ToValidUTF8(s, ""), maxBytes, opts, func(cluster string) int { return len(cluster) })
+}
+
+// truncateBy усекает строку по кластерам, размер которых вычисляет size.
+func truncateBy(s string, limit int, opts TruncateOptions, size func(string) int) string {
+	if limit <= 0 {
+		return ""
+	}
+
+	// Размер строки и позиция, до которой текст помещается вместе с многоточием
+	ellipsisSize := 0
+	for cluster := range GraphemeSeq(opts.Ellipsis) {
+		ellipsisSize += size(cluster)
+	}
+	ellipsis := opts.Ellipsis
+	if ellipsisSize >= limit {
+		ellipsis, ellipsisSize = "", 0
+	}
+
+	total, cut, wordCut := 0, 0, 0
+	for pos := 0; pos < len(s); {
+		n := graphemeClusterLen(s[pos:])
+		cluster := s[pos : pos+n]
+		total += size(cluster)
+		if total > limit {
+			break
+		}
+		if total <= limit-ellipsisSize {
+			cut = pos + n
+			if r, _ := utf8.DecodeRuneInString(cluster); unicode.IsSpace(r) {
+				wordCut = pos
+			}
+		}
+		pos += n
+	}
+	if total <= limit {
+		return s
+	}
+
+	// Граница слова: следующий за вырезанной частью символ — пробел
+	if opts.WordBoundary {
+		if r, _ := utf8.DecodeRuneInString(s[cut:]); !unicode.IsSpace(r) && wordCut > 0 {
+			cut = wordCut
+		}
+	}
+
+	head := strings.TrimRightFunc(s[:cut], unicode.IsSpace)
+	if opts.WordBoundary {
+		head = strings.TrimRightFunc(strings.TrimRight(head, truncateTrailing), unicode.IsSpace)
+	}
+	return head + ellipsis
+}
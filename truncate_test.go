@@ -0,0 +1,72 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"testing"
+	"unicode/utf8"
+)
+
+func TestTruncate(t *testing.T) {
+	words := TruncateOptions{Ellipsis: "…", WordBoundary: true}
+
+	tests := []struct {
+		name string
+		s    string
+		max  int
+		opts TruncateOptions
+		want string
+	}{
+		{"Короткая строка", "Привет", 10, words, "Привет"},
+		{"Ровно по длине", "Привет", 6, words, "Привет"},
+		{"По символам", "Привет, мир", 6, TruncateOptions{}, "Привет"},
+		{"С многоточием", "Привет, мир", 6, TruncateOptions{Ellipsis: "…"}, "Приве…"},
+		{"По границе слова", "Съешь же ещё этих мягких булок", 15, words, "Съешь же ещё…"},
+		{"Знаки препинания", "Привет, мир и все", 10, words, "Привет…"},
+		{"Длинное слово", "Превысокомногорассмотрительствующий", 8, words, "Превысо…"},
+		{"Граница совпадает с пробелом", "один два три", 8, words, "один…"},
+		{"Флаги", "🇷🇺🇺🇸🇩🇪🇫🇷", 3, TruncateOptions{}, "🇷🇺🇺🇸🇩🇪"},
+		{"Диакритика", "ééé", 2, TruncateOptions{}, "éé"},
+		{"ZWJ", "👨‍👩‍👧👍👍", 2, TruncateOptions{Ellipsis: "…"}, "👨‍👩‍👧…"},
+		{"Многоточие длиннее ограничения", "abcdef", 3, TruncateOptions{Ellipsis: "..."}, "abc"},
+		{"Нулевое ограничение", "abc", 0, words, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Truncate(tt.s, tt.max, tt.opts); got != tt.want {
+				t.Errorf("Truncate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTruncateBytes(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		max  int
+		opts TruncateOptions
+		want string
+	}{
+		{"Помещается", "Привет", 12, TruncateOptions{}, "Привет"},
+		{"Граница символа", "Привет", 7, TruncateOptions{}, "При"},
+		{"С многоточием", "Привет", 10, TruncateOptions{Ellipsis: "…"}, "При…"},
+		{"По границе слова", "Привет мир", 18, TruncateOptions{Ellipsis: "…", WordBoundary: true}, "Привет…"},
+		{"Эмодзи не разрываются", "a👨‍👩‍👧", 10, TruncateOptions{}, "a"},
+		{"Некорректный UTF-8", "ab\xff\xfecd", 3, TruncateOptions{}, "abc"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TruncateBytes(tt.s, tt.max, tt.opts)
+			if got != tt.want {
+				t.Errorf("TruncateBytes() = %q, want %q", got, tt.want)
+			}
+			if len(got) > tt.max || !utf8.ValidString(got) {
+				t.Errorf("TruncateBytes() = %q: превышен размер или некорректный UTF-8", got)
+			}
+		})
+	}
+}
@@ -99,7 +99,11 @@ func IsPrivateOrReservedIP(ip string) bool {
 	if err != nil {
 		return false
 	}
+	return isPrivateOrReservedAddr(addr)
+}
 
+// isPrivateOrReservedAddr проверяет, является ли адрес частным или зарезервированным.
+func isPrivateOrReservedAddr(addr netip.Addr) bool {
 	// Используем встроенные методы netip.Addr для базовой проверки
 	if addr.IsPrivate() || addr.IsLoopback() || addr.IsUnspecified() ||
 		addr.IsMulticast() || addr.IsLinkLocalUnicast() {
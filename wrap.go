@@ -0,0 +1,247 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/width"
+)
+
+// graphemeWidth возвращает ширину графемного кластера в моноширинном терминале:
+// 0 — управляющие и невидимые символы, 2 — широкие символы Восточной Азии и эмодзи, иначе 1.
+func graphemeWidth(cluster string) int {
+	if IsEmoji(cluster) {
+		return 2
+	}
+	for _, r := range cluster {
+		switch {
+		case unicode.IsControl(r), IsInvisible(r), unicode.Is(unicode.Mn, r), unicode.Is(unicode.Me, r):
+			continue
+		}
+		switch width.LookupRune(r).Kind() {
+		case width.EastAsianWide, width.EastAsianFullwidth:
+			return 2
+		}
+		return 1
+	}
+	return 0
+}
+
+// DisplayWidth возвращает ширину строки в колонках моноширинного шрифта: символы с диакритикой
+// и последовательности эмодзи считаются по графемным кластерам, иероглифы, кана, хангыль
+// и эмодзи занимают две колонки, невидимые символы — ни одной.
+// Пример: DisplayWidth("日本") = 4, DisplayWidth("👨‍👩‍👧") = 2.
+func DisplayWidth(s string) int {
+	total := 0
+	for cluster := range GraphemeSeq(s) {
+		total += graphemeWidth(cluster)
+	}
+	return total
+}
+
+// WrapOptions — параметры переноса текста.
+type WrapOptions struct {
+	// Width — максимальная ширина строки в колонках вместе с отступом; 0 — без переноса
+	Width int
+	// InitialIndent — отступ первой строки абзаца
+	InitialIndent string
+	// SubsequentIndent — отступ остальных строк абзаца (висячий отступ)
+	SubsequentIndent string
+	// KeepLongWords — не разрывать слова длиннее строки; по умолчанию такие слова
+	// разрываются по границам графем без добавления дефиса
+	KeepLongWords bool
+}
+
+// Wrap переносит текст по словам так, чтобы ширина строк (см. DisplayWidth) не превышала opts.Width.
+// Каждая строка исходного текста считается абзацем: переводы строк и пустые строки сохраняются,
+// поэтому результат ClearTextarea переносится без потери абзацев. Пробелы внутри абзаца схлопываются.
+func Wrap(text string, opts WrapOptions) string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	firstWidth := opts.Width - DisplayWidth(opts.InitialIndent)
+	restWidth := opts.Width - DisplayWidth(opts.SubsequentIndent)
+	if opts.Width > 0 {
+		firstWidth, restWidth = max(firstWidth, 1), max(restWidth, 1)
+	}
+
+	var lines []string
+	for paragraph := range strings.SplitSeq(text, "\n") {
+		wrapped := wrapParagraph(paragraph, firstWidth, restWidth, !opts.KeepLongWords)
+		if len(wrapped) == 0 {
+			lines = append(lines, "")
+			continue
+		}
+		for i, line := range wrapped {
+			indent := opts.SubsequentIndent
+			if i == 0 {
+				indent = opts.InitialIndent
+			}
+			lines = append(lines, indent+line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// wrapParagraph разбивает абзац на строки: первая строка не шире firstWidth, остальные — restWidth.
+// Слова длиннее строки разрываются по границам графем, если breakLong = true.
+// При ширине <= 0 абзац возвращается одной строкой.
+func wrapParagraph(text string, firstWidth, restWidth int, breakLong bool) []string {
+	words := strings.Fields(text)
+	if len(words) == 0 {
+		return nil
+	}
+	if firstWidth <= 0 || restWidth <= 0 {
+		return []string{strings.Join(words, " ")}
+	}
+
+	var lines []string
+	var line strings.Builder
+	lineWidth, limit := 0, firstWidth
+	newLine := func() {
+		lines = append(lines, line.String())
+		line.Reset()
+		lineWidth, limit = 0, restWidth
+	}
+
+	for _, word := range words {
+		wordWidth := DisplayWidth(word)
+		if lineWidth > 0 && lineWidth+1+wordWidth > limit {
+			newLine()
+		}
+		if lineWidth > 0 {
+			line.WriteByte(' ')
+			lineWidth++
+		}
+		if !breakLong || lineWidth+wordWidth <= limit {
+			line.WriteString(word)
+			lineWidth += wordWidth
+			continue
+		}
+
+		// Разрыв длинного слова по графемам
+		for cluster := range GraphemeSeq(word) {
+			w := graphemeWidth(cluster)
+			if lineWidth > 0 && lineWidth+w > limit {
+				newLine()
+			}
+			line.WriteString(cluster)
+			lineWidth += w
+		}
+	}
+	if line.Len() > 0 {
+		lines = append(lines, line.String())
+	}
+	return lines
+}
+
+// TableAlign — выравнивание столбца таблицы.
+type TableAlign int
+
+const (
+	// AlignLeft — по левому краю.
+	AlignLeft TableAlign = iota
+	// AlignRight — по правому краю (числа, суммы).
+	AlignRight
+	// AlignCenter — по центру.
+	AlignCenter
+)
+
+// TableOptions — параметры вывода таблицы.
+type TableOptions struct {
+	// Header — первая строка является заголовком и отделяется линией из дефисов
+	Header bool
+	// Align — выравнивание столбцов; для столбцов без значения — по левому краю
+	Align []TableAlign
+	// MaxColumnWidth — максимальная ширина столбца; более длинный текст переносится
+	// на следующие строки ячейки. 0 — без ограничения
+	MaxColumnWidth int
+	// Separator — разделитель столбцов; по умолчанию два пробела
+	Separator string
+}
+
+// RenderTable выводит таблицу моноширинным текстом для писем и консоли. Ширина столбцов
+// вычисляется по DisplayWidth, поэтому кириллица, иероглифы и эмодзи выравниваются корректно.
+// Переводы строк внутри ячейки и перенос по MaxColumnWidth дают многострочные ячейки.
+// Строки могут содержать разное число ячеек; пробелы в конце строк удаляются.
+func RenderTable(rows [][]string, opts TableOptions) string {
+	separator := opts.Separator
+	if separator == "" {
+		separator = "  "
+	}
+
+	// Ячейки, разбитые на строки, и ширина столбцов
+	columns := 0
+	for _, row := range rows {
+		columns = max(columns, len(row))
+	}
+	cells := make([][][]string, len(rows))
+	widths := make([]int, columns)
+	for i, row := range rows {
+		cells[i] = make([][]string, columns)
+		for j := range columns {
+			cell := ""
+			if j < len(row) {
+				cell = row[j]
+			}
+			var lines []string
+			for paragraph := range strings.SplitSeq(strings.ReplaceAll(cell, "\r\n", "\n"), "\n") {
+				wrapped := wrapParagraph(paragraph, opts.MaxColumnWidth, opts.MaxColumnWidth, true)
+				if len(wrapped) == 0 {
+					wrapped = []string{""}
+				}
+				lines = append(lines, wrapped...)
+			}
+			cells[i][j] = lines
+			for _, line := range lines {
+				widths[j] = max(widths[j], DisplayWidth(line))
+			}
+		}
+	}
+
+	var out []string
+	for i, row := range cells {
+		height := 0
+		for _, lines := range row {
+			height = max(height, len(lines))
+		}
+		for k := range height {
+			parts := make([]string, columns)
+			for j, lines := range row {
+				text := ""
+				if k < len(lines) {
+					text = lines[k]
+				}
+				align := AlignLeft
+				if j < len(opts.Align) {
+					align = opts.Align[j]
+				}
+				parts[j] = padCell(text, widths[j], align)
+			}
+			out = append(out, strings.TrimRight(strings.Join(parts, separator), " "))
+		}
+		if i == 0 && opts.Header {
+			parts := make([]string, columns)
+			for j, w := range widths {
+				parts[j] = strings.Repeat("-", w)
+			}
+			out = append(out, strings.Join(parts, separator))
+		}
+	}
+	return strings.Join(out, "\n")
+}
+
+// padCell дополняет текст пробелами до ширины столбца с учетом выравнивания.
+func padCell(text string, columnWidth int, align TableAlign) string {
+	pad := max(columnWidth-DisplayWidth(text), 0)
+	switch align {
+	case AlignRight:
+		return strings.Repeat(" ", pad) + text
+	case AlignCenter:
+		return strings.Repeat(" ", pad/2) + text + strings.Repeat(" ", pad-pad/2)
+	}
+	return text + strings.Repeat(" ", pad)
+}
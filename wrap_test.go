@@ -0,0 +1,157 @@
+// Copyright 2023-2025, Appercase LLC. All rights reserved.
+// https://www.appercase.ru/
+//
+// v1.2.3
+
+package helpers
+
+import "testing"
+
+func TestDisplayWidth(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{"hello", 5},
+		{"привет", 6},
+		{"日本語", 6},
+		{"ｱｲｳ", 3},
+		{"ＡＢ", 4},
+		{"é", 1},
+		{"👍🏽", 2},
+		{"👨\u200d👩\u200d👧", 2},
+		{"🇷🇺", 2},
+		{"a\u200bb", 2},
+		{"", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := DisplayWidth(tt.input); got != tt.expected {
+				t.Errorf("DisplayWidth(%q) = %d; ожидается %d", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestWrap(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		opts     WrapOptions
+		expected string
+	}{
+		{
+			"Перенос по словам",
+			"Съешь же ещё этих мягких французских булок",
+			WrapOptions{Width: 15},
+			"Съешь же ещё\nэтих мягких\nфранцузских\nбулок",
+		},
+		{
+			"Абзацы сохраняются",
+			ClearTextarea("Первый абзац текста\n\n\nВторой абзац"),
+			WrapOptions{Width: 12},
+			"Первый абзац\nтекста\nВторой абзац",
+		},
+		{
+			"Пустые строки",
+			"один\r\n\r\nдва",
+			WrapOptions{Width: 10},
+			"один\n\nдва",
+		},
+		{
+			"Висячий отступ",
+			"1. Пункт списка с длинным описанием",
+			WrapOptions{Width: 16, SubsequentIndent: "   "},
+			"1. Пункт списка\n   с длинным\n   описанием",
+		},
+		{
+			"Отступ первой строки",
+			"Абзац с красной строкой",
+			WrapOptions{Width: 12, InitialIndent: "    "},
+			"    Абзац с\nкрасной\nстрокой",
+		},
+		{
+			"Разрыв длинного слова",
+			"ссылка https://example.com/very/long/path",
+			WrapOptions{Width: 12},
+			"ссылка\nhttps://exam\nple.com/very\n/long/path",
+		},
+		{
+			"Длинное слово без разрыва",
+			"ссылка https://example.com/path",
+			WrapOptions{Width: 12, KeepLongWords: true},
+			"ссылка\nhttps://example.com/path",
+		},
+		{
+			"Широкие символы",
+			"日本語のテキスト",
+			WrapOptions{Width: 6},
+			"日本語\nのテキ\nスト",
+		},
+		{
+			"Эмодзи не разрываются",
+			"👨\u200d👩\u200d👧👍🏽🇷🇺",
+			WrapOptions{Width: 4},
+			"👨\u200d👩\u200d👧👍🏽\n🇷🇺",
+		},
+		{
+			"Без ширины",
+			"  много   пробелов  ",
+			WrapOptions{},
+			"много пробелов",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Wrap(tt.input, tt.opts); got != tt.expected {
+				t.Errorf("Wrap(%q) = %q; ожидается %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRenderTable(t *testing.T) {
+	tests := []struct {
+		name     string
+		rows     [][]string
+		opts     TableOptions
+		expected string
+	}{
+		{
+			"Заголовок и выравнивание",
+			[][]string{{"Товар", "Кол-во", "Цена"}, {"Чай", "2", "150,00"}, {"Кофе молотый", "10", "1 200,00"}},
+			TableOptions{Header: true, Align: []TableAlign{AlignLeft, AlignCenter, AlignRight}},
+			"Товар         Кол-во      Цена\n" +
+				"------------  ------  --------\n" +
+				"Чай             2       150,00\n" +
+				"Кофе молотый    10    1 200,00",
+		},
+		{
+			"Перенос в ячейках",
+			[][]string{{"1", "Очень длинное название товара"}, {"2", "Коротко"}},
+			TableOptions{MaxColumnWidth: 10, Separator: " | "},
+			"1 | Очень\n" +
+				"  | длинное\n" +
+				"  | название\n" +
+				"  | товара\n" +
+				"2 | Коротко",
+		},
+		{
+			"Широкие символы и пустые ячейки",
+			[][]string{{"日本", "x"}, {"a"}},
+			TableOptions{},
+			"日本  x\na",
+		},
+		{"Пустая таблица", nil, TableOptions{}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RenderTable(tt.rows, tt.opts); got != tt.expected {
+				t.Errorf("RenderTable() =\n%s\nожидается\n%s", got, tt.expected)
+			}
+		})
+	}
+}